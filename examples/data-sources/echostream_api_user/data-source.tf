@@ -0,0 +1,3 @@
+data "echostream_api_user" "ci" {
+  username = "abcdef123456"
+}
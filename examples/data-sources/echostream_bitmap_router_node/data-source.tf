@@ -0,0 +1,3 @@
+data "echostream_bitmap_router_node" "router" {
+  name = "router"
+}
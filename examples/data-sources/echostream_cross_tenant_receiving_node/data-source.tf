@@ -0,0 +1,3 @@
+data "echostream_cross_tenant_receiving_node" "receiving" {
+  name = "sending-tenant:sending-node"
+}
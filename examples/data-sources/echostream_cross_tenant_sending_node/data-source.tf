@@ -0,0 +1,3 @@
+data "echostream_cross_tenant_sending_node" "sending" {
+  name = "sending-node"
+}
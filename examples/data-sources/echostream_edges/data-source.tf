@@ -0,0 +1,18 @@
+data "echostream_edges" "all" {}
+
+data "echostream_edges" "into_processor" {
+  target = "my-processor"
+}
+
+resource "aws_cloudwatch_metric_alarm" "queue_depth" {
+  for_each            = { for edge in data.echostream_edges.all.edges : "${edge.source}|${edge.target}" => edge }
+  alarm_name          = "echostream-edge-${md5(each.key)}"
+  comparison_operator = "GreaterThanThreshold"
+  dimensions          = { QueueName = element(split(":", each.value.arn), 5) }
+  evaluation_periods  = 1
+  metric_name         = "ApproximateNumberOfMessagesVisible"
+  namespace           = "AWS/SQS"
+  period              = 300
+  statistic           = "Maximum"
+  threshold           = 1000
+}
@@ -0,0 +1,3 @@
+data "echostream_external_node" "external" {
+  name = "external"
+}
@@ -0,0 +1,3 @@
+data "echostream_files_dot_com_webhook_node" "files" {
+  name = "files"
+}
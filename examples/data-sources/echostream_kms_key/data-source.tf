@@ -0,0 +1,3 @@
+data "echostream_kms_key" "shared" {
+  name = "shared"
+}
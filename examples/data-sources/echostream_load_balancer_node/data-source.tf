@@ -0,0 +1,3 @@
+data "echostream_load_balancer_node" "balancer" {
+  name = "balancer"
+}
@@ -0,0 +1,3 @@
+data "echostream_managed_node" "managed" {
+  name = "managed"
+}
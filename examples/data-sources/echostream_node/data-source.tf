@@ -0,0 +1,11 @@
+data "echostream_node" "node" {
+  name = "processor"
+}
+
+output "node_type" {
+  value = data.echostream_node.node.type
+}
+
+output "processor_requirements" {
+  value = try(data.echostream_node.node.processor_node.requirements, null)
+}
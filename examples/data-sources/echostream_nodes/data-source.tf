@@ -0,0 +1,5 @@
+data "echostream_nodes" "hl7_processors" {
+  message_type = "echo.hl7"
+  name_regex   = "^hl7-"
+  types        = ["ProcessorNode"]
+}
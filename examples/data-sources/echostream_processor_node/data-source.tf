@@ -0,0 +1,3 @@
+data "echostream_processor_node" "processor" {
+  name = "processor"
+}
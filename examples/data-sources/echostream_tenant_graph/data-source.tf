@@ -0,0 +1,10 @@
+data "echostream_tenant_graph" "current" {}
+
+resource "local_file" "graph" {
+  content  = data.echostream_tenant_graph.current.dot
+  filename = "${path.module}/tenant.dot"
+}
+
+output "mermaid" {
+  value = data.echostream_tenant_graph.current.mermaid
+}
@@ -0,0 +1,3 @@
+data "echostream_tenant_user" "owner" {
+  email = "owner@example.com"
+}
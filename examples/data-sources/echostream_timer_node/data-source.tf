@@ -0,0 +1,3 @@
+data "echostream_timer_node" "timer" {
+  name = "timer"
+}
@@ -0,0 +1,3 @@
+data "echostream_web_sub_hub_node" "hub" {
+  name = "hub"
+}
@@ -0,0 +1,3 @@
+data "echostream_webhook_node" "webhook" {
+  name = "webhook"
+}
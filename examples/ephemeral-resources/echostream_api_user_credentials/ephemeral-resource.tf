@@ -0,0 +1,8 @@
+resource "echostream_api_user" "test" {
+  role              = "read_only"
+  store_credentials = false
+}
+
+ephemeral "echostream_api_user_credentials" "test" {
+  username = echostream_api_user.test.username
+}
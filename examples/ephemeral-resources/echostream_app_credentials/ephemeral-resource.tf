@@ -0,0 +1,8 @@
+resource "echostream_external_app" "test" {
+  name              = "test"
+  store_credentials = false
+}
+
+ephemeral "echostream_app_credentials" "test" {
+  name = echostream_external_app.test.name
+}
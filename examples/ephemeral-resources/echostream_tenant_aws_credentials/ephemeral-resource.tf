@@ -0,0 +1,9 @@
+ephemeral "echostream_tenant_aws_credentials" "current" {
+  duration = 900
+}
+
+provider "aws" {
+  access_key = ephemeral.echostream_tenant_aws_credentials.current.access_key_id
+  secret_key = ephemeral.echostream_tenant_aws_credentials.current.secret_access_key
+  token      = ephemeral.echostream_tenant_aws_credentials.current.session_token
+}
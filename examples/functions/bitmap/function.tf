@@ -0,0 +1,20 @@
+data "echostream_message_type" "text" {
+  name = "echo.text"
+}
+
+locals {
+  # The first flag is the least significant bit
+  flags = ["is_error", "is_warning", "is_audit"]
+}
+
+resource "echostream_bitmap_router_node" "test" {
+  name                 = "test"
+  inline_bitmapper     = data.echostream_message_type.text.bitmapper_template
+  receive_message_type = data.echostream_message_type.text.name
+  route_table = {
+    # "0x1"
+    (provider::echostream::bitmap(["is_error"], local.flags)) = ["node1", "node2"]
+    # "0x5"
+    (provider::echostream::bitmap(["is_error", "is_audit"], local.flags)) = ["node3"]
+  }
+}
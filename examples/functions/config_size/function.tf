@@ -0,0 +1,4 @@
+output "config_size" {
+  # 7
+  value = provider::echostream::config_size("{ \"a\": 1 }")
+}
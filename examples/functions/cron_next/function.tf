@@ -0,0 +1,4 @@
+output "next_fire_times" {
+  # ["2024-01-30T18:00:00Z", "2024-01-31T18:00:00Z", "2024-02-01T18:00:00Z"]
+  value = provider::echostream::cron_next("cron(0 18 ? * MON-FRI *)", "2024-01-30T12:00:00Z", 3)
+}
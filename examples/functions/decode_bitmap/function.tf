@@ -0,0 +1,4 @@
+output "flags" {
+  # ["is_error", "is_audit"]
+  value = provider::echostream::decode_bitmap("0x5", ["is_error", "is_warning", "is_audit"])
+}
@@ -0,0 +1,28 @@
+data "echostream_processor_function" "json_2_xml" {
+  name = "echo.json:echo.xml"
+}
+
+locals {
+  base = jsonencode({
+    log_level = "INFO"
+    database = {
+      host = "db.example.com"
+      port = 5432
+    }
+  })
+  dev = jsonencode({
+    log_level = "DEBUG"
+    database = {
+      host = "db.dev.example.com"
+    }
+  })
+}
+
+resource "echostream_processor_node" "test" {
+  # {"database":{"host":"db.dev.example.com","port":5432},"log_level":"DEBUG"}
+  config               = provider::echostream::merge_config(local.base, local.dev)
+  managed_processor    = data.echostream_processor_function.json_2_xml.name
+  name                 = "test"
+  receive_message_type = "echo.json"
+  send_message_type    = "echo.xml"
+}
@@ -0,0 +1,4 @@
+output "config" {
+  # "{\"a\":1,\"b\":[1,2]}"
+  value = provider::echostream::normalize_config("{ \"b\": [1, 2], \"a\": 1 }")
+}
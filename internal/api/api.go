@@ -6749,6 +6749,3468 @@ func (v *KmsKeyFields) GetInUse() bool { return v.InUse }
 // GetName returns KmsKeyFields.Name, and is useful for accessing the field via an interface.
 func (v *KmsKeyFields) GetName() string { return v.Name }
 
+// ListAppsListAppsAppConnection includes the requested fields of the GraphQL type AppConnection.
+type ListAppsListAppsAppConnection struct {
+	Apps      []ListAppsListAppsAppConnectionAppsApp `json:"-"`
+	NextToken *string                                `json:"nextToken"`
+}
+
+// GetApps returns ListAppsListAppsAppConnection.Apps, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnection) GetApps() []ListAppsListAppsAppConnectionAppsApp {
+	return v.Apps
+}
+
+// GetNextToken returns ListAppsListAppsAppConnection.NextToken, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnection) GetNextToken() *string { return v.NextToken }
+
+func (v *ListAppsListAppsAppConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListAppsListAppsAppConnection
+		Apps []json.RawMessage `json:"apps"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListAppsListAppsAppConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Apps
+		src := firstPass.Apps
+		*dst = make(
+			[]ListAppsListAppsAppConnectionAppsApp,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalListAppsListAppsAppConnectionAppsApp(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal ListAppsListAppsAppConnection.Apps: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalListAppsListAppsAppConnection struct {
+	Apps []json.RawMessage `json:"apps"`
+
+	NextToken *string `json:"nextToken"`
+}
+
+func (v *ListAppsListAppsAppConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListAppsListAppsAppConnection) __premarshalJSON() (*__premarshalListAppsListAppsAppConnection, error) {
+	var retval __premarshalListAppsListAppsAppConnection
+
+	{
+
+		dst := &retval.Apps
+		src := v.Apps
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalListAppsListAppsAppConnectionAppsApp(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal ListAppsListAppsAppConnection.Apps: %w", err)
+			}
+		}
+	}
+	retval.NextToken = v.NextToken
+	return &retval, nil
+}
+
+// ListAppsListAppsAppConnectionAppsApp includes the requested fields of the GraphQL interface App.
+//
+// ListAppsListAppsAppConnectionAppsApp is implemented by the following types:
+// ListAppsListAppsAppConnectionAppsCrossAccountApp
+// ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp
+// ListAppsListAppsAppConnectionAppsCrossTenantSendingApp
+// ListAppsListAppsAppConnectionAppsExternalApp
+// ListAppsListAppsAppConnectionAppsManagedApp
+type ListAppsListAppsAppConnectionAppsApp interface {
+	implementsGraphQLInterfaceListAppsListAppsAppConnectionAppsApp()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+	AppFields
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossAccountApp) implementsGraphQLInterfaceListAppsListAppsAppConnectionAppsApp() {
+}
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp) implementsGraphQLInterfaceListAppsListAppsAppConnectionAppsApp() {
+}
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantSendingApp) implementsGraphQLInterfaceListAppsListAppsAppConnectionAppsApp() {
+}
+func (v *ListAppsListAppsAppConnectionAppsExternalApp) implementsGraphQLInterfaceListAppsListAppsAppConnectionAppsApp() {
+}
+func (v *ListAppsListAppsAppConnectionAppsManagedApp) implementsGraphQLInterfaceListAppsListAppsAppConnectionAppsApp() {
+}
+
+func __unmarshalListAppsListAppsAppConnectionAppsApp(b []byte, v *ListAppsListAppsAppConnectionAppsApp) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CrossAccountApp":
+		*v = new(ListAppsListAppsAppConnectionAppsCrossAccountApp)
+		return json.Unmarshal(b, *v)
+	case "CrossTenantReceivingApp":
+		*v = new(ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp)
+		return json.Unmarshal(b, *v)
+	case "CrossTenantSendingApp":
+		*v = new(ListAppsListAppsAppConnectionAppsCrossTenantSendingApp)
+		return json.Unmarshal(b, *v)
+	case "ExternalApp":
+		*v = new(ListAppsListAppsAppConnectionAppsExternalApp)
+		return json.Unmarshal(b, *v)
+	case "ManagedApp":
+		*v = new(ListAppsListAppsAppConnectionAppsManagedApp)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing App.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ListAppsListAppsAppConnectionAppsApp: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalListAppsListAppsAppConnectionAppsApp(v *ListAppsListAppsAppConnectionAppsApp) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ListAppsListAppsAppConnectionAppsCrossAccountApp:
+		typename = "CrossAccountApp"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListAppsListAppsAppConnectionAppsCrossAccountApp
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp:
+		typename = "CrossTenantReceivingApp"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListAppsListAppsAppConnectionAppsCrossTenantReceivingApp
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListAppsListAppsAppConnectionAppsCrossTenantSendingApp:
+		typename = "CrossTenantSendingApp"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListAppsListAppsAppConnectionAppsCrossTenantSendingApp
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListAppsListAppsAppConnectionAppsExternalApp:
+		typename = "ExternalApp"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListAppsListAppsAppConnectionAppsExternalApp
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListAppsListAppsAppConnectionAppsManagedApp:
+		typename = "ManagedApp"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListAppsListAppsAppConnectionAppsManagedApp
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ListAppsListAppsAppConnectionAppsApp: "%T"`, v)
+	}
+}
+
+// ListAppsListAppsAppConnectionAppsCrossAccountApp includes the requested fields of the GraphQL type CrossAccountApp.
+type ListAppsListAppsAppConnectionAppsCrossAccountApp struct {
+	Typename                 *string `json:"__typename"`
+	AppFieldsCrossAccountApp `json:"-"`
+}
+
+// GetTypename returns ListAppsListAppsAppConnectionAppsCrossAccountApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossAccountApp) GetTypename() *string { return v.Typename }
+
+// GetDescription returns ListAppsListAppsAppConnectionAppsCrossAccountApp.Description, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossAccountApp) GetDescription() *string {
+	return v.AppFieldsCrossAccountApp.Description
+}
+
+// GetName returns ListAppsListAppsAppConnectionAppsCrossAccountApp.Name, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossAccountApp) GetName() string {
+	return v.AppFieldsCrossAccountApp.Name
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossAccountApp) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListAppsListAppsAppConnectionAppsCrossAccountApp
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListAppsListAppsAppConnectionAppsCrossAccountApp = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AppFieldsCrossAccountApp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListAppsListAppsAppConnectionAppsCrossAccountApp struct {
+	Typename *string `json:"__typename"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossAccountApp) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossAccountApp) __premarshalJSON() (*__premarshalListAppsListAppsAppConnectionAppsCrossAccountApp, error) {
+	var retval __premarshalListAppsListAppsAppConnectionAppsCrossAccountApp
+
+	retval.Typename = v.Typename
+	retval.Description = v.AppFieldsCrossAccountApp.Description
+	retval.Name = v.AppFieldsCrossAccountApp.Name
+	return &retval, nil
+}
+
+// ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp includes the requested fields of the GraphQL type CrossTenantReceivingApp.
+type ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp struct {
+	Typename                         *string `json:"__typename"`
+	AppFieldsCrossTenantReceivingApp `json:"-"`
+}
+
+// GetTypename returns ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetDescription returns ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp.Description, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp) GetDescription() *string {
+	return v.AppFieldsCrossTenantReceivingApp.Description
+}
+
+// GetName returns ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp.Name, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp) GetName() string {
+	return v.AppFieldsCrossTenantReceivingApp.Name
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AppFieldsCrossTenantReceivingApp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListAppsListAppsAppConnectionAppsCrossTenantReceivingApp struct {
+	Typename *string `json:"__typename"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantReceivingApp) __premarshalJSON() (*__premarshalListAppsListAppsAppConnectionAppsCrossTenantReceivingApp, error) {
+	var retval __premarshalListAppsListAppsAppConnectionAppsCrossTenantReceivingApp
+
+	retval.Typename = v.Typename
+	retval.Description = v.AppFieldsCrossTenantReceivingApp.Description
+	retval.Name = v.AppFieldsCrossTenantReceivingApp.Name
+	return &retval, nil
+}
+
+// ListAppsListAppsAppConnectionAppsCrossTenantSendingApp includes the requested fields of the GraphQL type CrossTenantSendingApp.
+type ListAppsListAppsAppConnectionAppsCrossTenantSendingApp struct {
+	Typename                       *string `json:"__typename"`
+	AppFieldsCrossTenantSendingApp `json:"-"`
+}
+
+// GetTypename returns ListAppsListAppsAppConnectionAppsCrossTenantSendingApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantSendingApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetDescription returns ListAppsListAppsAppConnectionAppsCrossTenantSendingApp.Description, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantSendingApp) GetDescription() *string {
+	return v.AppFieldsCrossTenantSendingApp.Description
+}
+
+// GetName returns ListAppsListAppsAppConnectionAppsCrossTenantSendingApp.Name, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantSendingApp) GetName() string {
+	return v.AppFieldsCrossTenantSendingApp.Name
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantSendingApp) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListAppsListAppsAppConnectionAppsCrossTenantSendingApp
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListAppsListAppsAppConnectionAppsCrossTenantSendingApp = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AppFieldsCrossTenantSendingApp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListAppsListAppsAppConnectionAppsCrossTenantSendingApp struct {
+	Typename *string `json:"__typename"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantSendingApp) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListAppsListAppsAppConnectionAppsCrossTenantSendingApp) __premarshalJSON() (*__premarshalListAppsListAppsAppConnectionAppsCrossTenantSendingApp, error) {
+	var retval __premarshalListAppsListAppsAppConnectionAppsCrossTenantSendingApp
+
+	retval.Typename = v.Typename
+	retval.Description = v.AppFieldsCrossTenantSendingApp.Description
+	retval.Name = v.AppFieldsCrossTenantSendingApp.Name
+	return &retval, nil
+}
+
+// ListAppsListAppsAppConnectionAppsExternalApp includes the requested fields of the GraphQL type ExternalApp.
+type ListAppsListAppsAppConnectionAppsExternalApp struct {
+	Typename             *string `json:"__typename"`
+	AppFieldsExternalApp `json:"-"`
+}
+
+// GetTypename returns ListAppsListAppsAppConnectionAppsExternalApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsExternalApp) GetTypename() *string { return v.Typename }
+
+// GetDescription returns ListAppsListAppsAppConnectionAppsExternalApp.Description, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsExternalApp) GetDescription() *string {
+	return v.AppFieldsExternalApp.Description
+}
+
+// GetName returns ListAppsListAppsAppConnectionAppsExternalApp.Name, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsExternalApp) GetName() string {
+	return v.AppFieldsExternalApp.Name
+}
+
+func (v *ListAppsListAppsAppConnectionAppsExternalApp) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListAppsListAppsAppConnectionAppsExternalApp
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListAppsListAppsAppConnectionAppsExternalApp = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AppFieldsExternalApp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListAppsListAppsAppConnectionAppsExternalApp struct {
+	Typename *string `json:"__typename"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListAppsListAppsAppConnectionAppsExternalApp) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListAppsListAppsAppConnectionAppsExternalApp) __premarshalJSON() (*__premarshalListAppsListAppsAppConnectionAppsExternalApp, error) {
+	var retval __premarshalListAppsListAppsAppConnectionAppsExternalApp
+
+	retval.Typename = v.Typename
+	retval.Description = v.AppFieldsExternalApp.Description
+	retval.Name = v.AppFieldsExternalApp.Name
+	return &retval, nil
+}
+
+// ListAppsListAppsAppConnectionAppsManagedApp includes the requested fields of the GraphQL type ManagedApp.
+type ListAppsListAppsAppConnectionAppsManagedApp struct {
+	Typename            *string `json:"__typename"`
+	AppFieldsManagedApp `json:"-"`
+}
+
+// GetTypename returns ListAppsListAppsAppConnectionAppsManagedApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsManagedApp) GetTypename() *string { return v.Typename }
+
+// GetDescription returns ListAppsListAppsAppConnectionAppsManagedApp.Description, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsManagedApp) GetDescription() *string {
+	return v.AppFieldsManagedApp.Description
+}
+
+// GetName returns ListAppsListAppsAppConnectionAppsManagedApp.Name, and is useful for accessing the field via an interface.
+func (v *ListAppsListAppsAppConnectionAppsManagedApp) GetName() string {
+	return v.AppFieldsManagedApp.Name
+}
+
+func (v *ListAppsListAppsAppConnectionAppsManagedApp) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListAppsListAppsAppConnectionAppsManagedApp
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListAppsListAppsAppConnectionAppsManagedApp = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AppFieldsManagedApp)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListAppsListAppsAppConnectionAppsManagedApp struct {
+	Typename *string `json:"__typename"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListAppsListAppsAppConnectionAppsManagedApp) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListAppsListAppsAppConnectionAppsManagedApp) __premarshalJSON() (*__premarshalListAppsListAppsAppConnectionAppsManagedApp, error) {
+	var retval __premarshalListAppsListAppsAppConnectionAppsManagedApp
+
+	retval.Typename = v.Typename
+	retval.Description = v.AppFieldsManagedApp.Description
+	retval.Name = v.AppFieldsManagedApp.Name
+	return &retval, nil
+}
+
+// ListAppsResponse is returned by ListApps on success.
+type ListAppsResponse struct {
+	ListApps ListAppsListAppsAppConnection `json:"ListApps"`
+}
+
+// GetListApps returns ListAppsResponse.ListApps, and is useful for accessing the field via an interface.
+func (v *ListAppsResponse) GetListApps() ListAppsListAppsAppConnection { return v.ListApps }
+
+// ListEdgesListEdgesEdgeConnection includes the requested fields of the GraphQL type EdgeConnection.
+type ListEdgesListEdgesEdgeConnection struct {
+	Edges     []ListEdgesListEdgesEdgeConnectionEdgesEdge `json:"edges"`
+	NextToken *string                                     `json:"nextToken"`
+}
+
+// GetEdges returns ListEdgesListEdgesEdgeConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnection) GetEdges() []ListEdgesListEdgesEdgeConnectionEdgesEdge {
+	return v.Edges
+}
+
+// GetNextToken returns ListEdgesListEdgesEdgeConnection.NextToken, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnection) GetNextToken() *string { return v.NextToken }
+
+// ListEdgesListEdgesEdgeConnectionEdgesEdge includes the requested fields of the GraphQL type Edge.
+type ListEdgesListEdgesEdgeConnectionEdgesEdge struct {
+	EdgeFields `json:"-"`
+}
+
+// GetArn returns ListEdgesListEdgesEdgeConnectionEdgesEdge.Arn, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) GetArn() string { return v.EdgeFields.Arn }
+
+// GetDescription returns ListEdgesListEdgesEdgeConnectionEdgesEdge.Description, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) GetDescription() *string {
+	return v.EdgeFields.Description
+}
+
+// GetKmsKey returns ListEdgesListEdgesEdgeConnectionEdgesEdge.KmsKey, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) GetKmsKey() *EdgeFieldsKmsKey {
+	return v.EdgeFields.KmsKey
+}
+
+// GetMaxReceiveCount returns ListEdgesListEdgesEdgeConnectionEdgesEdge.MaxReceiveCount, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) GetMaxReceiveCount() *int {
+	return v.EdgeFields.MaxReceiveCount
+}
+
+// GetMessageType returns ListEdgesListEdgesEdgeConnectionEdgesEdge.MessageType, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) GetMessageType() EdgeFieldsMessageType {
+	return v.EdgeFields.MessageType
+}
+
+// GetQueue returns ListEdgesListEdgesEdgeConnectionEdgesEdge.Queue, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) GetQueue() string { return v.EdgeFields.Queue }
+
+// GetSource returns ListEdgesListEdgesEdgeConnectionEdgesEdge.Source, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) GetSource() EdgeFieldsSourceNode {
+	return v.EdgeFields.Source
+}
+
+// GetTarget returns ListEdgesListEdgesEdgeConnectionEdgesEdge.Target, and is useful for accessing the field via an interface.
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) GetTarget() EdgeFieldsTargetNode {
+	return v.EdgeFields.Target
+}
+
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListEdgesListEdgesEdgeConnectionEdgesEdge
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListEdgesListEdgesEdgeConnectionEdgesEdge = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.EdgeFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListEdgesListEdgesEdgeConnectionEdgesEdge struct {
+	Arn string `json:"arn"`
+
+	Description *string `json:"description"`
+
+	KmsKey *EdgeFieldsKmsKey `json:"kmsKey"`
+
+	MaxReceiveCount *int `json:"maxReceiveCount"`
+
+	MessageType EdgeFieldsMessageType `json:"messageType"`
+
+	Queue string `json:"queue"`
+
+	Source json.RawMessage `json:"source"`
+
+	Target json.RawMessage `json:"target"`
+}
+
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListEdgesListEdgesEdgeConnectionEdgesEdge) __premarshalJSON() (*__premarshalListEdgesListEdgesEdgeConnectionEdgesEdge, error) {
+	var retval __premarshalListEdgesListEdgesEdgeConnectionEdgesEdge
+
+	retval.Arn = v.EdgeFields.Arn
+	retval.Description = v.EdgeFields.Description
+	retval.KmsKey = v.EdgeFields.KmsKey
+	retval.MaxReceiveCount = v.EdgeFields.MaxReceiveCount
+	retval.MessageType = v.EdgeFields.MessageType
+	retval.Queue = v.EdgeFields.Queue
+	{
+
+		dst := &retval.Source
+		src := v.EdgeFields.Source
+		var err error
+		*dst, err = __marshalEdgeFieldsSourceNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ListEdgesListEdgesEdgeConnectionEdgesEdge.EdgeFields.Source: %w", err)
+		}
+	}
+	{
+
+		dst := &retval.Target
+		src := v.EdgeFields.Target
+		var err error
+		*dst, err = __marshalEdgeFieldsTargetNode(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ListEdgesListEdgesEdgeConnectionEdgesEdge.EdgeFields.Target: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ListEdgesResponse is returned by ListEdges on success.
+type ListEdgesResponse struct {
+	ListEdges ListEdgesListEdgesEdgeConnection `json:"ListEdges"`
+}
+
+// GetListEdges returns ListEdgesResponse.ListEdges, and is useful for accessing the field via an interface.
+func (v *ListEdgesResponse) GetListEdges() ListEdgesListEdgesEdgeConnection { return v.ListEdges }
+
+// ListNodesListNodesNodeConnection includes the requested fields of the GraphQL type NodeConnection.
+type ListNodesListNodesNodeConnection struct {
+	NextToken *string                                     `json:"nextToken"`
+	Nodes     []ListNodesListNodesNodeConnectionNodesNode `json:"-"`
+}
+
+// GetNextToken returns ListNodesListNodesNodeConnection.NextToken, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnection) GetNextToken() *string { return v.NextToken }
+
+// GetNodes returns ListNodesListNodesNodeConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnection) GetNodes() []ListNodesListNodesNodeConnectionNodesNode {
+	return v.Nodes
+}
+
+func (v *ListNodesListNodesNodeConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]ListNodesListNodesNodeConnectionNodesNode,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalListNodesListNodesNodeConnectionNodesNode(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal ListNodesListNodesNodeConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnection struct {
+	NextToken *string `json:"nextToken"`
+
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *ListNodesListNodesNodeConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnection) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnection, error) {
+	var retval __premarshalListNodesListNodesNodeConnection
+
+	retval.NextToken = v.NextToken
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalListNodesListNodesNodeConnectionNodesNode(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal ListNodesListNodesNodeConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesAlertEmitterNode includes the requested fields of the GraphQL type AlertEmitterNode.
+type ListNodesListNodesNodeConnectionNodesAlertEmitterNode struct {
+	Typename                   *string `json:"__typename"`
+	NodeFieldsAlertEmitterNode `json:"-"`
+	SendMessageType            *ListNodesListNodesNodeConnectionNodesAlertEmitterNodeSendMessageType `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAlertEmitterNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesAlertEmitterNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesAlertEmitterNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesAlertEmitterNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNode) GetDescription() *string {
+	return v.NodeFieldsAlertEmitterNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAlertEmitterNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNode) GetName() string {
+	return v.NodeFieldsAlertEmitterNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesAlertEmitterNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesAlertEmitterNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsAlertEmitterNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesAlertEmitterNode struct {
+	Typename *string `json:"__typename"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesAlertEmitterNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesAlertEmitterNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesAlertEmitterNode
+
+	retval.Typename = v.Typename
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsAlertEmitterNode.Description
+	retval.Name = v.NodeFieldsAlertEmitterNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesAlertEmitterNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesAlertEmitterNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAlertEmitterNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode includes the requested fields of the GraphQL type AppChangeReceiverNode.
+type ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode struct {
+	Typename                        *string `json:"__typename"`
+	NodeFieldsAppChangeReceiverNode `json:"-"`
+	App                             ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp                 `json:"-"`
+	ReceiveMessageType              *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeReceiveMessageType `json:"receiveMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetApp returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode.App, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) GetApp() ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp {
+	return v.App
+}
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) GetDescription() *string {
+	return v.NodeFieldsAppChangeReceiverNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) GetName() string {
+	return v.NodeFieldsAppChangeReceiverNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode
+		App json.RawMessage `json:"app"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsAppChangeReceiverNode)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.App
+		src := firstPass.App
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode.App: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesAppChangeReceiverNode struct {
+	Typename *string `json:"__typename"`
+
+	App json.RawMessage `json:"app"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeReceiveMessageType `json:"receiveMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesAppChangeReceiverNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesAppChangeReceiverNode
+
+	retval.Typename = v.Typename
+	{
+
+		dst := &retval.App
+		src := v.App
+		var err error
+		*dst, err = __marshalListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode.App: %w", err)
+		}
+	}
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.Description = v.NodeFieldsAppChangeReceiverNode.Description
+	retval.Name = v.NodeFieldsAppChangeReceiverNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp includes the requested fields of the GraphQL interface App.
+//
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp is implemented by the following types:
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp
+type ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp interface {
+	implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+	// GetName returns the interface-field "name" from its implementation.
+	GetName() string
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp() {
+}
+
+func __unmarshalListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp(b []byte, v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CrossAccountApp":
+		*v = new(ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp)
+		return json.Unmarshal(b, *v)
+	case "CrossTenantReceivingApp":
+		*v = new(ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp)
+		return json.Unmarshal(b, *v)
+	case "CrossTenantSendingApp":
+		*v = new(ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp)
+		return json.Unmarshal(b, *v)
+	case "ExternalApp":
+		*v = new(ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp)
+		return json.Unmarshal(b, *v)
+	case "ManagedApp":
+		*v = new(ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing App.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp(v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp:
+		typename = "CrossAccountApp"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp:
+		typename = "CrossTenantReceivingApp"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp:
+		typename = "CrossTenantSendingApp"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp:
+		typename = "ExternalApp"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp:
+		typename = "ManagedApp"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeApp: "%T"`, v)
+	}
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp includes the requested fields of the GraphQL type CrossAccountApp.
+type ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp struct {
+	Typename *string `json:"__typename"`
+	Name     string  `json:"name"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossAccountApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp includes the requested fields of the GraphQL type CrossTenantReceivingApp.
+type ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp struct {
+	Typename *string `json:"__typename"`
+	Name     string  `json:"name"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantReceivingApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp includes the requested fields of the GraphQL type CrossTenantSendingApp.
+type ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp struct {
+	Typename *string `json:"__typename"`
+	Name     string  `json:"name"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppCrossTenantSendingApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp includes the requested fields of the GraphQL type ExternalApp.
+type ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp struct {
+	Typename *string `json:"__typename"`
+	Name     string  `json:"name"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppExternalApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp includes the requested fields of the GraphQL type ManagedApp.
+type ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp struct {
+	Typename *string `json:"__typename"`
+	Name     string  `json:"name"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeAppManagedApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeRouterNode includes the requested fields of the GraphQL type AppChangeRouterNode.
+type ListNodesListNodesNodeConnectionNodesAppChangeRouterNode struct {
+	Typename                      *string `json:"__typename"`
+	NodeFieldsAppChangeRouterNode `json:"-"`
+	ReceiveMessageType            *ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeReceiveMessageType `json:"receiveMessageType"`
+	SendMessageType               *ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeSendMessageType    `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAppChangeRouterNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesAppChangeRouterNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesAppChangeRouterNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesAppChangeRouterNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) GetDescription() *string {
+	return v.NodeFieldsAppChangeRouterNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeRouterNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) GetName() string {
+	return v.NodeFieldsAppChangeRouterNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesAppChangeRouterNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesAppChangeRouterNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsAppChangeRouterNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesAppChangeRouterNode struct {
+	Typename *string `json:"__typename"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeReceiveMessageType `json:"receiveMessageType"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesAppChangeRouterNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesAppChangeRouterNode
+
+	retval.Typename = v.Typename
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsAppChangeRouterNode.Description
+	retval.Name = v.NodeFieldsAppChangeRouterNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesAuditEmitterNode includes the requested fields of the GraphQL type AuditEmitterNode.
+type ListNodesListNodesNodeConnectionNodesAuditEmitterNode struct {
+	Typename                   *string `json:"__typename"`
+	NodeFieldsAuditEmitterNode `json:"-"`
+	SendMessageType            *ListNodesListNodesNodeConnectionNodesAuditEmitterNodeSendMessageType `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesAuditEmitterNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesAuditEmitterNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesAuditEmitterNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesAuditEmitterNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNode) GetDescription() *string {
+	return v.NodeFieldsAuditEmitterNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAuditEmitterNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNode) GetName() string {
+	return v.NodeFieldsAuditEmitterNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesAuditEmitterNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesAuditEmitterNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsAuditEmitterNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesAuditEmitterNode struct {
+	Typename *string `json:"__typename"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesAuditEmitterNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesAuditEmitterNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesAuditEmitterNode
+
+	retval.Typename = v.Typename
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsAuditEmitterNode.Description
+	retval.Name = v.NodeFieldsAuditEmitterNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesAuditEmitterNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesAuditEmitterNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesAuditEmitterNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesBitmapRouterNode includes the requested fields of the GraphQL type BitmapRouterNode.
+type ListNodesListNodesNodeConnectionNodesBitmapRouterNode struct {
+	Typename                   *string `json:"__typename"`
+	NodeFieldsBitmapRouterNode `json:"-"`
+	ReceiveMessageType         *ListNodesListNodesNodeConnectionNodesBitmapRouterNodeReceiveMessageType `json:"receiveMessageType"`
+	SendMessageType            *ListNodesListNodesNodeConnectionNodesBitmapRouterNodeSendMessageType    `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesBitmapRouterNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesBitmapRouterNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesBitmapRouterNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesBitmapRouterNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesBitmapRouterNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesBitmapRouterNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) GetDescription() *string {
+	return v.NodeFieldsBitmapRouterNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesBitmapRouterNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) GetName() string {
+	return v.NodeFieldsBitmapRouterNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesBitmapRouterNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesBitmapRouterNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsBitmapRouterNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesBitmapRouterNode struct {
+	Typename *string `json:"__typename"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesBitmapRouterNodeReceiveMessageType `json:"receiveMessageType"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesBitmapRouterNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesBitmapRouterNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesBitmapRouterNode
+
+	retval.Typename = v.Typename
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsBitmapRouterNode.Description
+	retval.Name = v.NodeFieldsBitmapRouterNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesBitmapRouterNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesBitmapRouterNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesBitmapRouterNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesBitmapRouterNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesBitmapRouterNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesBitmapRouterNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesChangeEmitterNode includes the requested fields of the GraphQL type ChangeEmitterNode.
+type ListNodesListNodesNodeConnectionNodesChangeEmitterNode struct {
+	Typename                    *string `json:"__typename"`
+	NodeFieldsChangeEmitterNode `json:"-"`
+	SendMessageType             *ListNodesListNodesNodeConnectionNodesChangeEmitterNodeSendMessageType `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesChangeEmitterNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesChangeEmitterNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesChangeEmitterNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesChangeEmitterNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNode) GetDescription() *string {
+	return v.NodeFieldsChangeEmitterNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesChangeEmitterNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNode) GetName() string {
+	return v.NodeFieldsChangeEmitterNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesChangeEmitterNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesChangeEmitterNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsChangeEmitterNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesChangeEmitterNode struct {
+	Typename *string `json:"__typename"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesChangeEmitterNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesChangeEmitterNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesChangeEmitterNode
+
+	retval.Typename = v.Typename
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsChangeEmitterNode.Description
+	retval.Name = v.NodeFieldsChangeEmitterNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesChangeEmitterNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesChangeEmitterNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesChangeEmitterNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode includes the requested fields of the GraphQL type CrossTenantReceivingNode.
+type ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode struct {
+	Typename                           *string `json:"__typename"`
+	NodeFieldsCrossTenantReceivingNode `json:"-"`
+	App                                ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeAppCrossTenantReceivingApp `json:"app"`
+	SendMessageType                    *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeSendMessageType           `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetApp returns ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode.App, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) GetApp() ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeAppCrossTenantReceivingApp {
+	return v.App
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) GetDescription() *string {
+	return v.NodeFieldsCrossTenantReceivingNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) GetName() string {
+	return v.NodeFieldsCrossTenantReceivingNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsCrossTenantReceivingNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode struct {
+	Typename *string `json:"__typename"`
+
+	App ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeAppCrossTenantReceivingApp `json:"app"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode
+
+	retval.Typename = v.Typename
+	retval.App = v.App
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsCrossTenantReceivingNode.Description
+	retval.Name = v.NodeFieldsCrossTenantReceivingNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeAppCrossTenantReceivingApp includes the requested fields of the GraphQL type CrossTenantReceivingApp.
+type ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeAppCrossTenantReceivingApp struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeAppCrossTenantReceivingApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeAppCrossTenantReceivingApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode includes the requested fields of the GraphQL type CrossTenantSendingNode.
+type ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode struct {
+	Typename                         *string `json:"__typename"`
+	NodeFieldsCrossTenantSendingNode `json:"-"`
+	App                              ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeAppCrossTenantSendingApp `json:"app"`
+	ReceiveMessageType               *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeReceiveMessageType      `json:"receiveMessageType"`
+	SendMessageType                  *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeSendMessageType         `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetApp returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode.App, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) GetApp() ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeAppCrossTenantSendingApp {
+	return v.App
+}
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) GetDescription() *string {
+	return v.NodeFieldsCrossTenantSendingNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) GetName() string {
+	return v.NodeFieldsCrossTenantSendingNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsCrossTenantSendingNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesCrossTenantSendingNode struct {
+	Typename *string `json:"__typename"`
+
+	App ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeAppCrossTenantSendingApp `json:"app"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeReceiveMessageType `json:"receiveMessageType"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesCrossTenantSendingNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesCrossTenantSendingNode
+
+	retval.Typename = v.Typename
+	retval.App = v.App
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsCrossTenantSendingNode.Description
+	retval.Name = v.NodeFieldsCrossTenantSendingNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeAppCrossTenantSendingApp includes the requested fields of the GraphQL type CrossTenantSendingApp.
+type ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeAppCrossTenantSendingApp struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeAppCrossTenantSendingApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeAppCrossTenantSendingApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode includes the requested fields of the GraphQL type DeadLetterEmitterNode.
+type ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode struct {
+	Typename                        *string `json:"__typename"`
+	NodeFieldsDeadLetterEmitterNode `json:"-"`
+	SendMessageType                 *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNodeSendMessageType `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode) GetDescription() *string {
+	return v.NodeFieldsDeadLetterEmitterNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode) GetName() string {
+	return v.NodeFieldsDeadLetterEmitterNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsDeadLetterEmitterNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode struct {
+	Typename *string `json:"__typename"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode
+
+	retval.Typename = v.Typename
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsDeadLetterEmitterNode.Description
+	retval.Name = v.NodeFieldsDeadLetterEmitterNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesExternalNode includes the requested fields of the GraphQL type ExternalNode.
+type ListNodesListNodesNodeConnectionNodesExternalNode struct {
+	Typename               *string `json:"__typename"`
+	NodeFieldsExternalNode `json:"-"`
+	App                    ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp        `json:"-"`
+	ReceiveMessageType     *ListNodesListNodesNodeConnectionNodesExternalNodeReceiveMessageType `json:"receiveMessageType"`
+	SendMessageType        *ListNodesListNodesNodeConnectionNodesExternalNodeSendMessageType    `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesExternalNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) GetTypename() *string { return v.Typename }
+
+// GetApp returns ListNodesListNodesNodeConnectionNodesExternalNode.App, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) GetApp() ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp {
+	return v.App
+}
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesExternalNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesExternalNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesExternalNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesExternalNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesExternalNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) GetDescription() *string {
+	return v.NodeFieldsExternalNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesExternalNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) GetName() string {
+	return v.NodeFieldsExternalNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesExternalNode
+		App json.RawMessage `json:"app"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesExternalNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsExternalNode)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.App
+		src := firstPass.App
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal ListNodesListNodesNodeConnectionNodesExternalNode.App: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesExternalNode struct {
+	Typename *string `json:"__typename"`
+
+	App json.RawMessage `json:"app"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesExternalNodeReceiveMessageType `json:"receiveMessageType"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesExternalNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesExternalNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesExternalNode
+
+	retval.Typename = v.Typename
+	{
+
+		dst := &retval.App
+		src := v.App
+		var err error
+		*dst, err = __marshalListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal ListNodesListNodesNodeConnectionNodesExternalNode.App: %w", err)
+		}
+	}
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsExternalNode.Description
+	retval.Name = v.NodeFieldsExternalNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp includes the requested fields of the GraphQL type CrossAccountApp.
+type ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp struct {
+	Typename *string `json:"__typename"`
+	Name     string  `json:"name"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp includes the requested fields of the GraphQL type ExternalApp.
+type ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp struct {
+	Typename *string `json:"__typename"`
+	Name     string  `json:"name"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp) GetTypename() *string {
+	return v.Typename
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp includes the requested fields of the GraphQL interface RemoteApp.
+//
+// ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp is implemented by the following types:
+// ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp
+// ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp
+type ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp interface {
+	implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp() {
+}
+
+func __unmarshalListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp(b []byte, v *ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CrossAccountApp":
+		*v = new(ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp)
+		return json.Unmarshal(b, *v)
+	case "ExternalApp":
+		*v = new(ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing RemoteApp.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp(v *ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp:
+		typename = "CrossAccountApp"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp
+		}{typename, v}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp:
+		typename = "ExternalApp"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ListNodesListNodesNodeConnectionNodesExternalNodeAppRemoteApp: "%T"`, v)
+	}
+}
+
+// ListNodesListNodesNodeConnectionNodesExternalNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesExternalNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesExternalNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesExternalNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesExternalNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesExternalNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesExternalNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode includes the requested fields of the GraphQL type FilesDotComWebhookNode.
+type ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode struct {
+	Typename                         *string `json:"__typename"`
+	NodeFieldsFilesDotComWebhookNode `json:"-"`
+	SendMessageType                  *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNodeSendMessageType `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode) GetDescription() *string {
+	return v.NodeFieldsFilesDotComWebhookNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode) GetName() string {
+	return v.NodeFieldsFilesDotComWebhookNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsFilesDotComWebhookNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode struct {
+	Typename *string `json:"__typename"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode
+
+	retval.Typename = v.Typename
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsFilesDotComWebhookNode.Description
+	retval.Name = v.NodeFieldsFilesDotComWebhookNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesLoadBalancerNode includes the requested fields of the GraphQL type LoadBalancerNode.
+type ListNodesListNodesNodeConnectionNodesLoadBalancerNode struct {
+	Typename                   *string `json:"__typename"`
+	NodeFieldsLoadBalancerNode `json:"-"`
+	ReceiveMessageType         *ListNodesListNodesNodeConnectionNodesLoadBalancerNodeReceiveMessageType `json:"receiveMessageType"`
+	SendMessageType            *ListNodesListNodesNodeConnectionNodesLoadBalancerNodeSendMessageType    `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesLoadBalancerNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesLoadBalancerNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesLoadBalancerNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesLoadBalancerNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesLoadBalancerNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesLoadBalancerNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) GetDescription() *string {
+	return v.NodeFieldsLoadBalancerNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesLoadBalancerNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) GetName() string {
+	return v.NodeFieldsLoadBalancerNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesLoadBalancerNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesLoadBalancerNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsLoadBalancerNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesLoadBalancerNode struct {
+	Typename *string `json:"__typename"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesLoadBalancerNodeReceiveMessageType `json:"receiveMessageType"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesLoadBalancerNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesLoadBalancerNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesLoadBalancerNode
+
+	retval.Typename = v.Typename
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsLoadBalancerNode.Description
+	retval.Name = v.NodeFieldsLoadBalancerNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesLoadBalancerNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesLoadBalancerNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesLoadBalancerNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesLoadBalancerNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesLoadBalancerNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesLoadBalancerNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesLogEmitterNode includes the requested fields of the GraphQL type LogEmitterNode.
+type ListNodesListNodesNodeConnectionNodesLogEmitterNode struct {
+	Typename                 *string `json:"__typename"`
+	NodeFieldsLogEmitterNode `json:"-"`
+	SendMessageType          *ListNodesListNodesNodeConnectionNodesLogEmitterNodeSendMessageType `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesLogEmitterNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesLogEmitterNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesLogEmitterNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesLogEmitterNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNode) GetDescription() *string {
+	return v.NodeFieldsLogEmitterNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesLogEmitterNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNode) GetName() string {
+	return v.NodeFieldsLogEmitterNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesLogEmitterNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesLogEmitterNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsLogEmitterNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesLogEmitterNode struct {
+	Typename *string `json:"__typename"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesLogEmitterNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesLogEmitterNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesLogEmitterNode
+
+	retval.Typename = v.Typename
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsLogEmitterNode.Description
+	retval.Name = v.NodeFieldsLogEmitterNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesLogEmitterNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesLogEmitterNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesLogEmitterNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesManagedNode includes the requested fields of the GraphQL type ManagedNode.
+type ListNodesListNodesNodeConnectionNodesManagedNode struct {
+	Typename              *string `json:"__typename"`
+	NodeFieldsManagedNode `json:"-"`
+	App                   ListNodesListNodesNodeConnectionNodesManagedNodeAppManagedApp       `json:"app"`
+	ReceiveMessageType    *ListNodesListNodesNodeConnectionNodesManagedNodeReceiveMessageType `json:"receiveMessageType"`
+	SendMessageType       *ListNodesListNodesNodeConnectionNodesManagedNodeSendMessageType    `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesManagedNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) GetTypename() *string { return v.Typename }
+
+// GetApp returns ListNodesListNodesNodeConnectionNodesManagedNode.App, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) GetApp() ListNodesListNodesNodeConnectionNodesManagedNodeAppManagedApp {
+	return v.App
+}
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesManagedNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesManagedNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesManagedNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesManagedNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesManagedNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) GetDescription() *string {
+	return v.NodeFieldsManagedNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesManagedNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) GetName() string {
+	return v.NodeFieldsManagedNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesManagedNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesManagedNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsManagedNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesManagedNode struct {
+	Typename *string `json:"__typename"`
+
+	App ListNodesListNodesNodeConnectionNodesManagedNodeAppManagedApp `json:"app"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesManagedNodeReceiveMessageType `json:"receiveMessageType"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesManagedNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesManagedNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesManagedNode
+
+	retval.Typename = v.Typename
+	retval.App = v.App
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsManagedNode.Description
+	retval.Name = v.NodeFieldsManagedNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesManagedNodeAppManagedApp includes the requested fields of the GraphQL type ManagedApp.
+type ListNodesListNodesNodeConnectionNodesManagedNodeAppManagedApp struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesManagedNodeAppManagedApp.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNodeAppManagedApp) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesManagedNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesManagedNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesManagedNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesManagedNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesManagedNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesManagedNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesManagedNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesNode includes the requested fields of the GraphQL interface Node.
+//
+// ListNodesListNodesNodeConnectionNodesNode is implemented by the following types:
+// ListNodesListNodesNodeConnectionNodesAlertEmitterNode
+// ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode
+// ListNodesListNodesNodeConnectionNodesAppChangeRouterNode
+// ListNodesListNodesNodeConnectionNodesAuditEmitterNode
+// ListNodesListNodesNodeConnectionNodesBitmapRouterNode
+// ListNodesListNodesNodeConnectionNodesChangeEmitterNode
+// ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode
+// ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode
+// ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode
+// ListNodesListNodesNodeConnectionNodesExternalNode
+// ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode
+// ListNodesListNodesNodeConnectionNodesLoadBalancerNode
+// ListNodesListNodesNodeConnectionNodesLogEmitterNode
+// ListNodesListNodesNodeConnectionNodesManagedNode
+// ListNodesListNodesNodeConnectionNodesProcessorNode
+// ListNodesListNodesNodeConnectionNodesTimerNode
+// ListNodesListNodesNodeConnectionNodesWebSubHubNode
+// ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode
+// ListNodesListNodesNodeConnectionNodesWebhookNode
+type ListNodesListNodesNodeConnectionNodesNode interface {
+	implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+	NodeFields
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesAlertEmitterNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesAuditEmitterNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesBitmapRouterNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesChangeEmitterNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesExternalNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesLoadBalancerNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesLogEmitterNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesManagedNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesTimerNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNode) implementsGraphQLInterfaceListNodesListNodesNodeConnectionNodesNode() {
+}
+
+func __unmarshalListNodesListNodesNodeConnectionNodesNode(b []byte, v *ListNodesListNodesNodeConnectionNodesNode) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AlertEmitterNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesAlertEmitterNode)
+		return json.Unmarshal(b, *v)
+	case "AppChangeReceiverNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode)
+		return json.Unmarshal(b, *v)
+	case "AppChangeRouterNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesAppChangeRouterNode)
+		return json.Unmarshal(b, *v)
+	case "AuditEmitterNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesAuditEmitterNode)
+		return json.Unmarshal(b, *v)
+	case "BitmapRouterNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesBitmapRouterNode)
+		return json.Unmarshal(b, *v)
+	case "ChangeEmitterNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesChangeEmitterNode)
+		return json.Unmarshal(b, *v)
+	case "CrossTenantReceivingNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode)
+		return json.Unmarshal(b, *v)
+	case "CrossTenantSendingNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode)
+		return json.Unmarshal(b, *v)
+	case "DeadLetterEmitterNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode)
+		return json.Unmarshal(b, *v)
+	case "ExternalNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesExternalNode)
+		return json.Unmarshal(b, *v)
+	case "FilesDotComWebhookNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode)
+		return json.Unmarshal(b, *v)
+	case "LoadBalancerNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesLoadBalancerNode)
+		return json.Unmarshal(b, *v)
+	case "LogEmitterNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesLogEmitterNode)
+		return json.Unmarshal(b, *v)
+	case "ManagedNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesManagedNode)
+		return json.Unmarshal(b, *v)
+	case "ProcessorNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesProcessorNode)
+		return json.Unmarshal(b, *v)
+	case "TimerNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesTimerNode)
+		return json.Unmarshal(b, *v)
+	case "WebSubHubNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesWebSubHubNode)
+		return json.Unmarshal(b, *v)
+	case "WebSubSubscriptionNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode)
+		return json.Unmarshal(b, *v)
+	case "WebhookNode":
+		*v = new(ListNodesListNodesNodeConnectionNodesWebhookNode)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Node.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ListNodesListNodesNodeConnectionNodesNode: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalListNodesListNodesNodeConnectionNodesNode(v *ListNodesListNodesNodeConnectionNodesNode) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ListNodesListNodesNodeConnectionNodesAlertEmitterNode:
+		typename = "AlertEmitterNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesAlertEmitterNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode:
+		typename = "AppChangeReceiverNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesAppChangeReceiverNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesAppChangeRouterNode:
+		typename = "AppChangeRouterNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesAppChangeRouterNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesAuditEmitterNode:
+		typename = "AuditEmitterNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesAuditEmitterNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesBitmapRouterNode:
+		typename = "BitmapRouterNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesBitmapRouterNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesChangeEmitterNode:
+		typename = "ChangeEmitterNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesChangeEmitterNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode:
+		typename = "CrossTenantReceivingNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode:
+		typename = "CrossTenantSendingNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesCrossTenantSendingNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode:
+		typename = "DeadLetterEmitterNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesExternalNode:
+		typename = "ExternalNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesExternalNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode:
+		typename = "FilesDotComWebhookNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesLoadBalancerNode:
+		typename = "LoadBalancerNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesLoadBalancerNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesLogEmitterNode:
+		typename = "LogEmitterNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesLogEmitterNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesManagedNode:
+		typename = "ManagedNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesManagedNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesProcessorNode:
+		typename = "ProcessorNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesProcessorNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesTimerNode:
+		typename = "TimerNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesTimerNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesWebSubHubNode:
+		typename = "WebSubHubNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesWebSubHubNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode:
+		typename = "WebSubSubscriptionNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ListNodesListNodesNodeConnectionNodesWebhookNode:
+		typename = "WebhookNode"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalListNodesListNodesNodeConnectionNodesWebhookNode
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ListNodesListNodesNodeConnectionNodesNode: "%T"`, v)
+	}
+}
+
+// ListNodesListNodesNodeConnectionNodesProcessorNode includes the requested fields of the GraphQL type ProcessorNode.
+type ListNodesListNodesNodeConnectionNodesProcessorNode struct {
+	Typename                *string `json:"__typename"`
+	NodeFieldsProcessorNode `json:"-"`
+	ReceiveMessageType      *ListNodesListNodesNodeConnectionNodesProcessorNodeReceiveMessageType `json:"receiveMessageType"`
+	SendMessageType         *ListNodesListNodesNodeConnectionNodesProcessorNodeSendMessageType    `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesProcessorNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) GetTypename() *string { return v.Typename }
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesProcessorNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesProcessorNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesProcessorNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesProcessorNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesProcessorNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) GetDescription() *string {
+	return v.NodeFieldsProcessorNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesProcessorNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) GetName() string {
+	return v.NodeFieldsProcessorNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesProcessorNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesProcessorNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsProcessorNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesProcessorNode struct {
+	Typename *string `json:"__typename"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesProcessorNodeReceiveMessageType `json:"receiveMessageType"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesProcessorNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesProcessorNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesProcessorNode
+
+	retval.Typename = v.Typename
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsProcessorNode.Description
+	retval.Name = v.NodeFieldsProcessorNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesProcessorNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesProcessorNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesProcessorNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesProcessorNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesProcessorNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesProcessorNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesProcessorNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesTimerNode includes the requested fields of the GraphQL type TimerNode.
+type ListNodesListNodesNodeConnectionNodesTimerNode struct {
+	Typename            *string `json:"__typename"`
+	NodeFieldsTimerNode `json:"-"`
+	SendMessageType     *ListNodesListNodesNodeConnectionNodesTimerNodeSendMessageType `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesTimerNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesTimerNode) GetTypename() *string { return v.Typename }
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesTimerNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesTimerNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesTimerNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesTimerNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesTimerNode) GetDescription() *string {
+	return v.NodeFieldsTimerNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesTimerNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesTimerNode) GetName() string {
+	return v.NodeFieldsTimerNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesTimerNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesTimerNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesTimerNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsTimerNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesTimerNode struct {
+	Typename *string `json:"__typename"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesTimerNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesTimerNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesTimerNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesTimerNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesTimerNode
+
+	retval.Typename = v.Typename
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsTimerNode.Description
+	retval.Name = v.NodeFieldsTimerNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesTimerNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesTimerNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesTimerNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesTimerNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesWebSubHubNode includes the requested fields of the GraphQL type WebSubHubNode.
+type ListNodesListNodesNodeConnectionNodesWebSubHubNode struct {
+	Typename                *string `json:"__typename"`
+	NodeFieldsWebSubHubNode `json:"-"`
+	ReceiveMessageType      *ListNodesListNodesNodeConnectionNodesWebSubHubNodeReceiveMessageType `json:"receiveMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesWebSubHubNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNode) GetTypename() *string { return v.Typename }
+
+// GetReceiveMessageType returns ListNodesListNodesNodeConnectionNodesWebSubHubNode.ReceiveMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNode) GetReceiveMessageType() *ListNodesListNodesNodeConnectionNodesWebSubHubNodeReceiveMessageType {
+	return v.ReceiveMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesWebSubHubNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNode) GetDescription() *string {
+	return v.NodeFieldsWebSubHubNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesWebSubHubNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNode) GetName() string {
+	return v.NodeFieldsWebSubHubNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesWebSubHubNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesWebSubHubNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsWebSubHubNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesWebSubHubNode struct {
+	Typename *string `json:"__typename"`
+
+	ReceiveMessageType *ListNodesListNodesNodeConnectionNodesWebSubHubNodeReceiveMessageType `json:"receiveMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesWebSubHubNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesWebSubHubNode
+
+	retval.Typename = v.Typename
+	retval.ReceiveMessageType = v.ReceiveMessageType
+	retval.Description = v.NodeFieldsWebSubHubNode.Description
+	retval.Name = v.NodeFieldsWebSubHubNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesWebSubHubNodeReceiveMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesWebSubHubNodeReceiveMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesWebSubHubNodeReceiveMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebSubHubNodeReceiveMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode includes the requested fields of the GraphQL type WebSubSubscriptionNode.
+type ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode struct {
+	Typename                         *string `json:"__typename"`
+	NodeFieldsWebSubSubscriptionNode `json:"-"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode) GetTypename() *string {
+	return v.Typename
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode) GetDescription() *string {
+	return v.NodeFieldsWebSubSubscriptionNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode) GetName() string {
+	return v.NodeFieldsWebSubSubscriptionNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsWebSubSubscriptionNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode struct {
+	Typename *string `json:"__typename"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesWebSubSubscriptionNode
+
+	retval.Typename = v.Typename
+	retval.Description = v.NodeFieldsWebSubSubscriptionNode.Description
+	retval.Name = v.NodeFieldsWebSubSubscriptionNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesWebhookNode includes the requested fields of the GraphQL type WebhookNode.
+type ListNodesListNodesNodeConnectionNodesWebhookNode struct {
+	Typename              *string `json:"__typename"`
+	NodeFieldsWebhookNode `json:"-"`
+	SendMessageType       *ListNodesListNodesNodeConnectionNodesWebhookNodeSendMessageType `json:"sendMessageType"`
+}
+
+// GetTypename returns ListNodesListNodesNodeConnectionNodesWebhookNode.Typename, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNode) GetTypename() *string { return v.Typename }
+
+// GetSendMessageType returns ListNodesListNodesNodeConnectionNodesWebhookNode.SendMessageType, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNode) GetSendMessageType() *ListNodesListNodesNodeConnectionNodesWebhookNodeSendMessageType {
+	return v.SendMessageType
+}
+
+// GetDescription returns ListNodesListNodesNodeConnectionNodesWebhookNode.Description, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNode) GetDescription() *string {
+	return v.NodeFieldsWebhookNode.Description
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesWebhookNode.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNode) GetName() string {
+	return v.NodeFieldsWebhookNode.Name
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListNodesListNodesNodeConnectionNodesWebhookNode
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListNodesListNodesNodeConnectionNodesWebhookNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.NodeFieldsWebhookNode)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListNodesListNodesNodeConnectionNodesWebhookNode struct {
+	Typename *string `json:"__typename"`
+
+	SendMessageType *ListNodesListNodesNodeConnectionNodesWebhookNodeSendMessageType `json:"sendMessageType"`
+
+	Description *string `json:"description"`
+
+	Name string `json:"name"`
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNode) __premarshalJSON() (*__premarshalListNodesListNodesNodeConnectionNodesWebhookNode, error) {
+	var retval __premarshalListNodesListNodesNodeConnectionNodesWebhookNode
+
+	retval.Typename = v.Typename
+	retval.SendMessageType = v.SendMessageType
+	retval.Description = v.NodeFieldsWebhookNode.Description
+	retval.Name = v.NodeFieldsWebhookNode.Name
+	return &retval, nil
+}
+
+// ListNodesListNodesNodeConnectionNodesWebhookNodeSendMessageType includes the requested fields of the GraphQL type MessageType.
+type ListNodesListNodesNodeConnectionNodesWebhookNodeSendMessageType struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ListNodesListNodesNodeConnectionNodesWebhookNodeSendMessageType.Name, and is useful for accessing the field via an interface.
+func (v *ListNodesListNodesNodeConnectionNodesWebhookNodeSendMessageType) GetName() string {
+	return v.Name
+}
+
+// ListNodesResponse is returned by ListNodes on success.
+type ListNodesResponse struct {
+	ListNodes ListNodesListNodesNodeConnection `json:"ListNodes"`
+}
+
+// GetListNodes returns ListNodesResponse.ListNodes, and is useful for accessing the field via an interface.
+func (v *ListNodesResponse) GetListNodes() ListNodesListNodesNodeConnection { return v.ListNodes }
+
 // LoadBalancerNodeFields includes the GraphQL fields of LoadBalancerNode requested by the fragment LoadBalancerNodeFields.
 type LoadBalancerNodeFields struct {
 	ReceiveMessageType *LoadBalancerNodeFieldsReceiveMessageType `json:"receiveMessageType"`
@@ -23998,6 +27460,42 @@ func (v *__DeleteTenantUserInput) GetEmail() string { return v.Email }
 // GetTenant returns __DeleteTenantUserInput.Tenant, and is useful for accessing the field via an interface.
 func (v *__DeleteTenantUserInput) GetTenant() string { return v.Tenant }
 
+// __ListAppsInput is used internally by genqlient
+type __ListAppsInput struct {
+	Tenant    string  `json:"tenant"`
+	NextToken *string `json:"nextToken"`
+}
+
+// GetTenant returns __ListAppsInput.Tenant, and is useful for accessing the field via an interface.
+func (v *__ListAppsInput) GetTenant() string { return v.Tenant }
+
+// GetNextToken returns __ListAppsInput.NextToken, and is useful for accessing the field via an interface.
+func (v *__ListAppsInput) GetNextToken() *string { return v.NextToken }
+
+// __ListEdgesInput is used internally by genqlient
+type __ListEdgesInput struct {
+	Tenant    string  `json:"tenant"`
+	NextToken *string `json:"nextToken"`
+}
+
+// GetTenant returns __ListEdgesInput.Tenant, and is useful for accessing the field via an interface.
+func (v *__ListEdgesInput) GetTenant() string { return v.Tenant }
+
+// GetNextToken returns __ListEdgesInput.NextToken, and is useful for accessing the field via an interface.
+func (v *__ListEdgesInput) GetNextToken() *string { return v.NextToken }
+
+// __ListNodesInput is used internally by genqlient
+type __ListNodesInput struct {
+	Tenant    string  `json:"tenant"`
+	NextToken *string `json:"nextToken"`
+}
+
+// GetTenant returns __ListNodesInput.Tenant, and is useful for accessing the field via an interface.
+func (v *__ListNodesInput) GetTenant() string { return v.Tenant }
+
+// GetNextToken returns __ListNodesInput.NextToken, and is useful for accessing the field via an interface.
+func (v *__ListNodesInput) GetNextToken() *string { return v.NextToken }
+
 // __MoveEdgeInput is used internally by genqlient
 type __MoveEdgeInput struct {
 	Source    string `json:"source"`
@@ -26641,6 +30139,289 @@ func DeleteTenantUser(
 	return &data_, err_
 }
 
+// The query or mutation executed by ListApps.
+const ListApps_Operation = `
+query ListApps ($tenant: String!, $nextToken: String) {
+	ListApps(tenant: $tenant, nextToken: $nextToken) {
+		apps {
+			__typename
+			... AppFields
+		}
+		nextToken
+	}
+}
+fragment AppFields on App {
+	description
+	name
+}
+`
+
+func ListApps(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	tenant string,
+	nextToken *string,
+) (*ListAppsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ListApps",
+		Query:  ListApps_Operation,
+		Variables: &__ListAppsInput{
+			Tenant:    tenant,
+			NextToken: nextToken,
+		},
+	}
+	var err_ error
+
+	var data_ ListAppsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ListEdges.
+const ListEdges_Operation = `
+query ListEdges ($tenant: String!, $nextToken: String) {
+	ListEdges(tenant: $tenant, nextToken: $nextToken) {
+		edges {
+			... EdgeFields
+		}
+		nextToken
+	}
+}
+fragment EdgeFields on Edge {
+	arn
+	description
+	kmsKey {
+		name
+	}
+	maxReceiveCount
+	messageType {
+		name
+	}
+	queue
+	source {
+		__typename
+		name
+	}
+	target {
+		__typename
+		name
+	}
+}
+`
+
+func ListEdges(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	tenant string,
+	nextToken *string,
+) (*ListEdgesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ListEdges",
+		Query:  ListEdges_Operation,
+		Variables: &__ListEdgesInput{
+			Tenant:    tenant,
+			NextToken: nextToken,
+		},
+	}
+	var err_ error
+
+	var data_ ListEdgesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by ListNodes.
+const ListNodes_Operation = `
+query ListNodes ($tenant: String!, $nextToken: String) {
+	ListNodes(tenant: $tenant, nextToken: $nextToken) {
+		nextToken
+		nodes {
+			__typename
+			... NodeFields
+			... on AlertEmitterNode {
+				sendMessageType {
+					name
+				}
+			}
+			... on AppChangeReceiverNode {
+				app {
+					__typename
+					name
+				}
+				receiveMessageType {
+					name
+				}
+			}
+			... on AppChangeRouterNode {
+				receiveMessageType {
+					name
+				}
+				sendMessageType {
+					name
+				}
+			}
+			... on AuditEmitterNode {
+				sendMessageType {
+					name
+				}
+			}
+			... on BitmapRouterNode {
+				receiveMessageType {
+					name
+				}
+				sendMessageType {
+					name
+				}
+			}
+			... on ChangeEmitterNode {
+				sendMessageType {
+					name
+				}
+			}
+			... on CrossTenantReceivingNode {
+				app {
+					name
+				}
+				sendMessageType {
+					name
+				}
+			}
+			... on CrossTenantSendingNode {
+				app {
+					name
+				}
+				receiveMessageType {
+					name
+				}
+				sendMessageType {
+					name
+				}
+			}
+			... on DeadLetterEmitterNode {
+				sendMessageType {
+					name
+				}
+			}
+			... on ExternalNode {
+				app {
+					__typename
+					... on CrossAccountApp {
+						name
+					}
+					... on ExternalApp {
+						name
+					}
+				}
+				receiveMessageType {
+					name
+				}
+				sendMessageType {
+					name
+				}
+			}
+			... on FilesDotComWebhookNode {
+				sendMessageType {
+					name
+				}
+			}
+			... on LoadBalancerNode {
+				receiveMessageType {
+					name
+				}
+				sendMessageType {
+					name
+				}
+			}
+			... on LogEmitterNode {
+				sendMessageType {
+					name
+				}
+			}
+			... on ManagedNode {
+				app {
+					name
+				}
+				receiveMessageType {
+					name
+				}
+				sendMessageType {
+					name
+				}
+			}
+			... on ProcessorNode {
+				receiveMessageType {
+					name
+				}
+				sendMessageType {
+					name
+				}
+			}
+			... on TimerNode {
+				sendMessageType {
+					name
+				}
+			}
+			... on WebhookNode {
+				sendMessageType {
+					name
+				}
+			}
+			... on WebSubHubNode {
+				receiveMessageType {
+					name
+				}
+			}
+		}
+	}
+}
+fragment NodeFields on Node {
+	description
+	name
+}
+`
+
+func ListNodes(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	tenant string,
+	nextToken *string,
+) (*ListNodesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ListNodes",
+		Query:  ListNodes_Operation,
+		Variables: &__ListNodesInput{
+			Tenant:    tenant,
+			NextToken: nextToken,
+		},
+	}
+	var err_ error
+
+	var data_ ListNodesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by MoveEdge.
 const MoveEdge_Operation = `
 query MoveEdge ($source: String!, $target: String!, $tenant: String!, $newSource: String!, $newTarget: String!) {
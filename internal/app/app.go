@@ -1,8 +1,10 @@
 package app
 
 import (
+	"context"
 	"regexp"
 
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -85,6 +87,7 @@ func remoteAppResourceAttributes() map[string]resourceSchema.Attribute {
 				CustomType:          common.ConfigType{},
 				MarkdownDescription: "The config for the app. All nodes in the app will be allowed to access this. Must be a JSON object.",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{common.ConfigDiffPlanModifier},
 				Sensitive:           true,
 			},
 			"credentials": resourceSchema.SingleNestedAttribute{
@@ -92,6 +95,7 @@ func remoteAppResourceAttributes() map[string]resourceSchema.Attribute {
 				Computed:            true,
 				MarkdownDescription: "The AWS Cognito Credentials that allow the app to access the EchoStream GraphQL API.",
 			},
+			"store_credentials": common.StoreCredentialsResourceAttribute("echostream_app_credentials"),
 			"table_access": resourceSchema.BoolAttribute{
 				Computed:            true,
 				MarkdownDescription: "Indicates if this app can gain access to the Tenant's DynamoDB [table](https://docs.echo.stream/docs/table).",
@@ -123,3 +127,15 @@ func managedAppInstanceAttributes() map[string]resourceSchema.Attribute {
 		},
 	}
 }
+
+// appExists returns a function that reports whether the named App exists, for use with the
+// common waiters.
+func appExists(data *common.ProviderData, name string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadApp(ctx, data.Client, name, data.Tenant)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetApp != nil, nil
+	}
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResourceWithConfigure = &AppCredentialsEphemeralResource{}
+
+type AppCredentialsEphemeralResource struct {
+	data *common.ProviderData
+}
+
+type appCredentialsModel struct {
+	Credentials types.Object `tfsdk:"credentials"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (r *AppCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *AppCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_credentials"
+}
+
+func (r *AppCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config appCredentialsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	echoResp, err := api.ReadApp(ctx, r.data.Client, config.Name.ValueString(), r.data.Tenant)
+	if err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading App", err))
+		return
+	} else if echoResp.GetApp == nil {
+		resp.Diagnostics.AddError("App not found", fmt.Sprintf("Unable to find App '%s'", config.Name.ValueString()))
+		return
+	}
+
+	var credentials *api.CognitoCredentialsFields
+	switch app := (*echoResp.GetApp).(type) {
+	case *api.ReadAppGetAppCrossAccountApp:
+		credentials = &app.Credentials.CognitoCredentialsFields
+	case *api.ReadAppGetAppExternalApp:
+		credentials = &app.Credentials.CognitoCredentialsFields
+	case *api.ReadAppGetAppManagedApp:
+		credentials = &app.Credentials.CognitoCredentialsFields
+	default:
+		resp.Diagnostics.AddError(
+			"Incorrect App type",
+			fmt.Sprintf("'%s' is not a CrossAccountApp, ExternalApp or ManagedApp, which have credentials", config.Name.ValueString()),
+		)
+		return
+	}
+
+	credentialsValue, diags := common.CognitoCredentialsValue(types.BoolValue(true), credentials)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Credentials = credentialsValue
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func (r *AppCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"credentials": schema.SingleNestedAttribute{
+				Attributes:          common.CognitoCredentialsEphemeralResourceSchema(),
+				Computed:            true,
+				MarkdownDescription: "The AWS Cognito Credentials that allow the app to access the EchoStream GraphQL API.",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the CrossAccountApp, ExternalApp or ManagedApp.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "The AWS Cognito Credentials of a CrossAccountApp, ExternalApp or ManagedApp. " +
+			"As an ephemeral resource, they are never stored in the plan or state.",
+	}
+}
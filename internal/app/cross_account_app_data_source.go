@@ -54,7 +54,7 @@ func (d *CrossAccountAppDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	if echoResp, err := api.ReadApp(ctx, d.data.Client, config.Name.ValueString(), d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading CrossAccountApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading CrossAccountApp", err))
 		return
 	} else if echoResp.GetApp != nil {
 		switch app := (*echoResp.GetApp).(type) {
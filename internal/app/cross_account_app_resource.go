@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,6 +32,12 @@ type CrossAccountAppResource struct {
 	data *common.ProviderData
 }
 
+type crossAccountAppResourceModel struct {
+	crossAccountAppModel
+	StoreCredentials types.Bool     `tfsdk:"store_credentials"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *CrossAccountAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -52,7 +58,7 @@ func (r *CrossAccountAppResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *CrossAccountAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan crossAccountAppModel
+	var plan crossAccountAppResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -90,7 +96,7 @@ func (r *CrossAccountAppResource) Create(ctx context.Context, req resource.Creat
 		description,
 		tableAccess,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating CrossAccountApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating CrossAccountApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		plan.Account = types.StringValue(echoResp.CreateCrossAccountApp.Account)
@@ -102,15 +108,7 @@ func (r *CrossAccountAppResource) Create(ctx context.Context, req resource.Creat
 			plan.Config = common.ConfigNull()
 		}
 		var diags diag.Diagnostics
-		plan.Credentials, diags = types.ObjectValue(
-			common.CognitoCredentialsAttrTypes(),
-			common.CognitoCredentialsAttrValues(
-				echoResp.CreateCrossAccountApp.Credentials.ClientId,
-				echoResp.CreateCrossAccountApp.Credentials.Password,
-				echoResp.CreateCrossAccountApp.Credentials.UserPoolId,
-				echoResp.CreateCrossAccountApp.Credentials.Username,
-			),
-		)
+		plan.Credentials, diags = common.CognitoCredentialsValue(plan.StoreCredentials, &echoResp.CreateCrossAccountApp.Credentials.CognitoCredentialsFields)
 		if diags != nil && diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 		}
@@ -129,7 +127,7 @@ func (r *CrossAccountAppResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *CrossAccountAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state crossAccountAppModel
+	var state crossAccountAppResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -138,12 +136,24 @@ func (r *CrossAccountAppResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting CrossAccountApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting CrossAccountApp", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, appExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for CrossAccountApp to be deleted", err.Error())
+	}
 }
 
 func (r *CrossAccountAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -155,7 +165,7 @@ func (r *CrossAccountAppResource) Metadata(ctx context.Context, req resource.Met
 }
 
 func (r *CrossAccountAppResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state crossAccountAppModel
+	var state crossAccountAppResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -176,7 +186,7 @@ func (r *CrossAccountAppResource) ModifyPlan(ctx context.Context, req resource.M
 			fmt.Sprintf("This will terminate the connection with %s permanently!!", state.Account.ValueString()),
 		)
 	} else {
-		var plan crossAccountAppModel
+		var plan crossAccountAppResourceModel
 
 		// Read Terraform plan data into the model
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -195,7 +205,7 @@ func (r *CrossAccountAppResource) ModifyPlan(ctx context.Context, req resource.M
 }
 
 func (r *CrossAccountAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state crossAccountAppModel
+	var state crossAccountAppResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -204,8 +214,17 @@ func (r *CrossAccountAppResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// Imported resources have no configuration yet, so use the default
+	if state.StoreCredentials.IsNull() {
+		state.StoreCredentials = types.BoolValue(true)
+	}
+
 	if echoResp, err := api.ReadApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading CrossAccountApp", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading CrossAccountApp", err))
 		return
 	} else if echoResp.GetApp != nil {
 		switch app := (*echoResp.GetApp).(type) {
@@ -220,15 +239,7 @@ func (r *CrossAccountAppResource) Read(ctx context.Context, req resource.ReadReq
 				state.Config = common.ConfigNull()
 			}
 			var diags diag.Diagnostics
-			state.Credentials, diags = types.ObjectValue(
-				common.CognitoCredentialsAttrTypes(),
-				common.CognitoCredentialsAttrValues(
-					app.Credentials.ClientId,
-					app.Credentials.Password,
-					app.Credentials.UserPoolId,
-					app.Credentials.Username,
-				),
-			)
+			state.Credentials, diags = common.CognitoCredentialsValue(state.StoreCredentials, &app.Credentials.CognitoCredentialsFields)
 			if diags != nil && diags.HasError() {
 				resp.Diagnostics.Append(diags...)
 			}
@@ -284,13 +295,16 @@ func (r *CrossAccountAppResource) Schema(ctx context.Context, req resource.Schem
 		},
 	)
 	resp.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[CrossAccountApps](https://docs.echo.stream/docs/cross-account-app) provides a way to receive/send messages in their Nodes using cross-account IAM access.",
 	}
 }
 
 func (r *CrossAccountAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan crossAccountAppModel
+	var plan crossAccountAppResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -299,6 +313,16 @@ func (r *CrossAccountAppResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config      *string
 		description *string
@@ -328,7 +352,7 @@ func (r *CrossAccountAppResource) Update(ctx context.Context, req resource.Updat
 		tableAccess,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating CrossAccountApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating CrossAccountApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -344,15 +368,7 @@ func (r *CrossAccountAppResource) Update(ctx context.Context, req resource.Updat
 			plan.Config = common.ConfigNull()
 		}
 		var diags diag.Diagnostics
-		plan.Credentials, diags = types.ObjectValue(
-			common.CognitoCredentialsAttrTypes(),
-			common.CognitoCredentialsAttrValues(
-				app.Update.Credentials.ClientId,
-				app.Update.Credentials.Password,
-				app.Update.Credentials.UserPoolId,
-				app.Update.Credentials.Username,
-			),
-		)
+		plan.Credentials, diags = common.CognitoCredentialsValue(plan.StoreCredentials, &app.Update.Credentials.CognitoCredentialsFields)
 		if diags != nil && diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 		}
@@ -3,10 +3,10 @@ package app
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,10 +29,11 @@ type CrossTenantReceivingAppResource struct {
 }
 
 type crossTenantReceivingAppModel struct {
-	Description   types.String `tfsdk:"description"`
-	Name          types.String `tfsdk:"name"`
-	SendingApp    types.String `tfsdk:"sending_app"`
-	SendingTenant types.String `tfsdk:"sending_tenant"`
+	Description   types.String   `tfsdk:"description"`
+	Name          types.String   `tfsdk:"name"`
+	SendingApp    types.String   `tfsdk:"sending_app"`
+	SendingTenant types.String   `tfsdk:"sending_tenant"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *CrossTenantReceivingAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -71,7 +72,7 @@ func (r *CrossTenantReceivingAppResource) Create(ctx context.Context, req resour
 		plan.SendingTenant.ValueString(),
 		r.data.Tenant,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating CrossTenantReceivingApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating CrossTenantReceivingApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		plan.Description = types.StringValue(*echoResp.CreateCrossTenantReceivingApp.Description)
@@ -94,12 +95,24 @@ func (r *CrossTenantReceivingAppResource) Delete(ctx context.Context, req resour
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting CrossTenentReceivingApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting CrossTenentReceivingApp", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, appExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for CrossTenantReceivingApp to be deleted", err.Error())
+	}
 }
 
 func (r *CrossTenantReceivingAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -166,7 +179,11 @@ func (r *CrossTenantReceivingAppResource) Read(ctx context.Context, req resource
 	}
 
 	if echoResp, err := api.ReadApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading CrossTenantReceivingApp", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading CrossTenantReceivingApp", err))
 		return
 	} else if echoResp.GetApp != nil {
 		switch app := (*echoResp.GetApp).(type) {
@@ -212,7 +229,10 @@ func (r *CrossTenantReceivingAppResource) Schema(ctx context.Context, req resour
 		},
 	)
 	resp.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[CrossTenantReceivingApps](https://docs.echo.stream/docs/cross-tenant-app) provide a way to receive messages from other EchoStream Tenants.",
 	}
 }
@@ -227,6 +247,16 @@ func (r *CrossTenantReceivingAppResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var description *string
 
 	if !(plan.Description.IsNull() || plan.Description.IsUnknown()) {
@@ -242,7 +272,7 @@ func (r *CrossTenantReceivingAppResource) Update(ctx context.Context, req resour
 		description,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating CrossTenantReceivingApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating CrossTenantReceivingApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
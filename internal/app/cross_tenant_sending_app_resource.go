@@ -3,10 +3,10 @@ package app
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,10 +29,11 @@ type CrossTenantSendingAppResource struct {
 }
 
 type crossTenantSendingAppModel struct {
-	Description     types.String `tfsdk:"description"`
-	Name            types.String `tfsdk:"name"`
-	ReceivingApp    types.String `tfsdk:"receiving_app"`
-	ReceivingTenant types.String `tfsdk:"receiving_tenant"`
+	Description     types.String   `tfsdk:"description"`
+	Name            types.String   `tfsdk:"name"`
+	ReceivingApp    types.String   `tfsdk:"receiving_app"`
+	ReceivingTenant types.String   `tfsdk:"receiving_tenant"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *CrossTenantSendingAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -72,7 +73,7 @@ func (r *CrossTenantSendingAppResource) Create(ctx context.Context, req resource
 		plan.ReceivingTenant.ValueString(),
 		r.data.Tenant,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating CrossTenantSendingApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating CrossTenantSendingApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.CreateCrossTenantSendingApp.Description != nil {
@@ -99,12 +100,24 @@ func (r *CrossTenantSendingAppResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting CrossTenantSendingApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting CrossTenantSendingApp", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, appExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for CrossTenantSendingApp to be deleted", err.Error())
+	}
 }
 
 func (r *CrossTenantSendingAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -168,7 +181,11 @@ func (r *CrossTenantSendingAppResource) Read(ctx context.Context, req resource.R
 	}
 
 	if echoResp, err := api.ReadApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading CrossTenantSendingApp", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading CrossTenantSendingApp", err))
 		return
 	} else if echoResp.GetApp != nil {
 		switch app := (*echoResp.GetApp).(type) {
@@ -214,7 +231,10 @@ func (r *CrossTenantSendingAppResource) Schema(ctx context.Context, req resource
 	description.Computed = true
 	attributes["description"] = description
 	resp.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[CrossTenantSendingApps](https://docs.echo.stream/docs/cross-tenant-app) provide a way to send messages to another EchoStream Tenant.",
 	}
 }
@@ -229,6 +249,16 @@ func (r *CrossTenantSendingAppResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var description *string
 
 	if !(plan.Description.IsNull() || plan.Description.IsUnknown()) {
@@ -244,7 +274,7 @@ func (r *CrossTenantSendingAppResource) Update(ctx context.Context, req resource
 		description,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating CrossTenantSendingApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating CrossTenantSendingApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -54,7 +54,7 @@ func (d *ExternalAppDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	if echoResp, err := api.ReadApp(ctx, d.data.Client, config.Name.ValueString(), d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ExternalApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ExternalApp", err))
 		return
 	} else if echoResp.GetApp != nil {
 		switch app := (*echoResp.GetApp).(type) {
@@ -3,10 +3,10 @@ package app
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +27,12 @@ type ExternalAppResource struct {
 	data *common.ProviderData
 }
 
+type externalAppResourceModel struct {
+	externalAppModel
+	StoreCredentials types.Bool     `tfsdk:"store_credentials"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *ExternalAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -47,7 +53,7 @@ func (r *ExternalAppResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *ExternalAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan externalAppModel
+	var plan externalAppResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -84,7 +90,7 @@ func (r *ExternalAppResource) Create(ctx context.Context, req resource.CreateReq
 		description,
 		tableAccess,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating ExternalApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ExternalApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		plan.AppsyncEndpoint = types.StringValue(echoResp.CreateExternalApp.AppsyncEndpoint)
@@ -95,15 +101,7 @@ func (r *ExternalAppResource) Create(ctx context.Context, req resource.CreateReq
 			plan.Config = common.ConfigNull()
 		}
 		var diags diag.Diagnostics
-		plan.Credentials, diags = types.ObjectValue(
-			common.CognitoCredentialsAttrTypes(),
-			common.CognitoCredentialsAttrValues(
-				echoResp.CreateExternalApp.Credentials.ClientId,
-				echoResp.CreateExternalApp.Credentials.Password,
-				echoResp.CreateExternalApp.Credentials.UserPoolId,
-				echoResp.CreateExternalApp.Credentials.Username,
-			),
-		)
+		plan.Credentials, diags = common.CognitoCredentialsValue(plan.StoreCredentials, &echoResp.CreateExternalApp.Credentials.CognitoCredentialsFields)
 		if diags != nil && diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 		}
@@ -121,7 +119,7 @@ func (r *ExternalAppResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *ExternalAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state externalAppModel
+	var state externalAppResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -130,12 +128,24 @@ func (r *ExternalAppResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting ExternalApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ExternalApp", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, appExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ExternalApp to be deleted", err.Error())
+	}
 }
 
 func (r *ExternalAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -147,7 +157,7 @@ func (r *ExternalAppResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *ExternalAppResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state externalAppModel
+	var state externalAppResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -168,7 +178,7 @@ func (r *ExternalAppResource) ModifyPlan(ctx context.Context, req resource.Modif
 			"This will terminate the connection with your remote compute resources permanently!!",
 		)
 	} else {
-		var plan externalAppModel
+		var plan externalAppResourceModel
 
 		// Read Terraform plan data into the model
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -187,7 +197,7 @@ func (r *ExternalAppResource) ModifyPlan(ctx context.Context, req resource.Modif
 }
 
 func (r *ExternalAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state externalAppModel
+	var state externalAppResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -196,8 +206,17 @@ func (r *ExternalAppResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// Imported resources have no configuration yet, so use the default
+	if state.StoreCredentials.IsNull() {
+		state.StoreCredentials = types.BoolValue(true)
+	}
+
 	if echoResp, err := api.ReadApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ExternalApp", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ExternalApp", err))
 		return
 	} else if echoResp.GetApp != nil {
 		switch app := (*echoResp.GetApp).(type) {
@@ -211,15 +230,7 @@ func (r *ExternalAppResource) Read(ctx context.Context, req resource.ReadRequest
 				state.Config = common.ConfigNull()
 			}
 			var diags diag.Diagnostics
-			state.Credentials, diags = types.ObjectValue(
-				common.CognitoCredentialsAttrTypes(),
-				common.CognitoCredentialsAttrValues(
-					app.Credentials.ClientId,
-					app.Credentials.Password,
-					app.Credentials.UserPoolId,
-					app.Credentials.Username,
-				),
-			)
+			state.Credentials, diags = common.CognitoCredentialsValue(state.StoreCredentials, &app.Credentials.CognitoCredentialsFields)
 			if diags != nil && diags.HasError() {
 				resp.Diagnostics.Append(diags...)
 			}
@@ -258,13 +269,16 @@ func (r *ExternalAppResource) Schema(ctx context.Context, req resource.SchemaReq
 		},
 	)
 	resp.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[ExternalApps](https://docs.echo.stream/docs/external-app) provide a way to process messages in their Nodes using any compute resource.",
 	}
 }
 
 func (r *ExternalAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan externalAppModel
+	var plan externalAppResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -273,6 +287,16 @@ func (r *ExternalAppResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config      *string
 		description *string
@@ -302,7 +326,7 @@ func (r *ExternalAppResource) Update(ctx context.Context, req resource.UpdateReq
 		tableAccess,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating ExternalApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ExternalApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -317,15 +341,7 @@ func (r *ExternalAppResource) Update(ctx context.Context, req resource.UpdateReq
 			plan.Config = common.ConfigNull()
 		}
 		var diags diag.Diagnostics
-		plan.Credentials, diags = types.ObjectValue(
-			common.CognitoCredentialsAttrTypes(),
-			common.CognitoCredentialsAttrValues(
-				app.Update.Credentials.ClientId,
-				app.Update.Credentials.Password,
-				app.Update.Credentials.UserPoolId,
-				app.Update.Credentials.Username,
-			),
-		)
+		plan.Credentials, diags = common.CognitoCredentialsValue(plan.StoreCredentials, &app.Update.Credentials.CognitoCredentialsFields)
 		if diags != nil && diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 		}
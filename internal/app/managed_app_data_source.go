@@ -53,7 +53,7 @@ func (d *ManagedAppDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	if echoResp, err := api.ReadApp(ctx, d.data.Client, config.Name.ValueString(), d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ManagedApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ManagedApp", err))
 		return
 	} else if echoResp.GetApp != nil {
 		switch app := (*echoResp.GetApp).(type) {
@@ -61,7 +61,7 @@ func (r *ManagedAppInstanceIsoResource) Create(ctx context.Context, req resource
 		plan.App.ValueString(),
 		r.data.Tenant,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating ManagedAppInstanceIso", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ManagedAppInstanceIso", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.GetApp != nil {
@@ -61,7 +61,7 @@ func (r *ManagedAppInstanceUserdataResource) Create(ctx context.Context, req res
 		plan.App.ValueString(),
 		r.data.Tenant,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating ManagedAppInstanceUserdata", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ManagedAppInstanceUserdata", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.GetApp != nil {
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +29,12 @@ type ManagedAppResource struct {
 	data *common.ProviderData
 }
 
+type managedAppResourceModel struct {
+	managedAppModel
+	StoreCredentials types.Bool     `tfsdk:"store_credentials"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *ManagedAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -49,7 +55,7 @@ func (r *ManagedAppResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *ManagedAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan managedAppModel
+	var plan managedAppResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -86,7 +92,7 @@ func (r *ManagedAppResource) Create(ctx context.Context, req resource.CreateRequ
 		description,
 		tableAccess,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating ManagedApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ManagedApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		plan.AuditRecordsEndpoint = types.StringValue(echoResp.CreateManagedApp.AuditRecordsEndpoint)
@@ -96,15 +102,7 @@ func (r *ManagedAppResource) Create(ctx context.Context, req resource.CreateRequ
 			plan.Config = common.ConfigNull()
 		}
 		var diags diag.Diagnostics
-		plan.Credentials, diags = types.ObjectValue(
-			common.CognitoCredentialsAttrTypes(),
-			common.CognitoCredentialsAttrValues(
-				echoResp.CreateManagedApp.Credentials.ClientId,
-				echoResp.CreateManagedApp.Credentials.Password,
-				echoResp.CreateManagedApp.Credentials.UserPoolId,
-				echoResp.CreateManagedApp.Credentials.Username,
-			),
-		)
+		plan.Credentials, diags = common.CognitoCredentialsValue(plan.StoreCredentials, &echoResp.CreateManagedApp.Credentials.CognitoCredentialsFields)
 		if diags != nil && diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 		}
@@ -122,7 +120,7 @@ func (r *ManagedAppResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *ManagedAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state managedAppModel
+	var state managedAppResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -131,12 +129,24 @@ func (r *ManagedAppResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting ManagedApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ManagedApp", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, appExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ManagedApp to be deleted", err.Error())
+	}
 }
 
 func (r *ManagedAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -148,7 +158,7 @@ func (r *ManagedAppResource) Metadata(ctx context.Context, req resource.Metadata
 }
 
 func (r *ManagedAppResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state managedAppModel
+	var state managedAppResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -169,7 +179,7 @@ func (r *ManagedAppResource) ModifyPlan(ctx context.Context, req resource.Modify
 			"This will terminate the connection with your remote compute resources permanently!!",
 		)
 	} else {
-		var plan managedAppModel
+		var plan managedAppResourceModel
 
 		// Read Terraform plan data into the model
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -188,7 +198,7 @@ func (r *ManagedAppResource) ModifyPlan(ctx context.Context, req resource.Modify
 }
 
 func (r *ManagedAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state managedAppModel
+	var state managedAppResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -197,8 +207,17 @@ func (r *ManagedAppResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Imported resources have no configuration yet, so use the default
+	if state.StoreCredentials.IsNull() {
+		state.StoreCredentials = types.BoolValue(true)
+	}
+
 	if echoResp, err := api.ReadApp(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ManagedApp", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ManagedApp", err))
 		return
 	} else if echoResp.GetApp != nil {
 		switch app := (*echoResp.GetApp).(type) {
@@ -211,15 +230,7 @@ func (r *ManagedAppResource) Read(ctx context.Context, req resource.ReadRequest,
 				state.Config = common.ConfigNull()
 			}
 			var diags diag.Diagnostics
-			state.Credentials, diags = types.ObjectValue(
-				common.CognitoCredentialsAttrTypes(),
-				common.CognitoCredentialsAttrValues(
-					app.Credentials.ClientId,
-					app.Credentials.Password,
-					app.Credentials.UserPoolId,
-					app.Credentials.Username,
-				),
-			)
+			state.Credentials, diags = common.CognitoCredentialsValue(state.StoreCredentials, &app.Credentials.CognitoCredentialsFields)
 			if diags != nil && diags.HasError() {
 				resp.Diagnostics.Append(diags...)
 			}
@@ -258,13 +269,16 @@ func (r *ManagedAppResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 	attributes["name"] = name
 	resp.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[ManagedApps](https://docs.echo.stream/docs/managed-app) provide fully managed (by EchoStream) processing resources in a remote virtual compute environment that you manage.",
 	}
 }
 
 func (r *ManagedAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan managedAppModel
+	var plan managedAppResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -273,6 +287,16 @@ func (r *ManagedAppResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config      *string
 		description *string
@@ -302,7 +326,7 @@ func (r *ManagedAppResource) Update(ctx context.Context, req resource.UpdateRequ
 		tableAccess,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating ManagedApp", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ManagedApp", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -316,15 +340,7 @@ func (r *ManagedAppResource) Update(ctx context.Context, req resource.UpdateRequ
 			plan.Config = common.ConfigNull()
 		}
 		var diags diag.Diagnostics
-		plan.Credentials, diags = types.ObjectValue(
-			common.CognitoCredentialsAttrTypes(),
-			common.CognitoCredentialsAttrValues(
-				app.Update.Credentials.ClientId,
-				app.Update.Credentials.Password,
-				app.Update.Credentials.UserPoolId,
-				app.Update.Credentials.Username,
-			),
-		)
+		plan.Credentials, diags = common.CognitoCredentialsValue(plan.StoreCredentials, &app.Update.Credentials.CognitoCredentialsFields)
 		if diags != nil && diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 		}
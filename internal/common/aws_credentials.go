@@ -3,6 +3,7 @@ package common
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -57,3 +58,26 @@ func AwsCredentialsSchema() map[string]dsschema.Attribute {
 		},
 	}
 }
+
+func AwsCredentialsEphemeralResourceSchema() map[string]ephemeralschema.Attribute {
+	return map[string]ephemeralschema.Attribute{
+		"access_key_id": ephemeralschema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The AWS Acces Key Id for the session.",
+		},
+		"expiration": ephemeralschema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date/time that the sesssion expires, in [ISO8601](https://en.wikipedia.org/wiki/ISO_8601) format.",
+		},
+		"secret_access_key": ephemeralschema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The AWS Secret Access Key for the session.",
+			Sensitive:           true,
+		},
+		"session_token": ephemeralschema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The AWS Session Token for the session.",
+			Sensitive:           true,
+		},
+	}
+}
@@ -1,9 +1,13 @@
 package common
 
 import (
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dataSourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralSchema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -37,6 +41,23 @@ func CognitoCredentialsAttrValues(
 	}
 }
 
+// CognitoCredentialsValue returns credentials as an object, or a null object
+// if store is false. A null store (e.g. - after an import) stores them.
+func CognitoCredentialsValue(store types.Bool, credentials *api.CognitoCredentialsFields) (types.Object, diag.Diagnostics) {
+	if !(store.IsNull() || store.IsUnknown() || store.ValueBool()) {
+		return types.ObjectNull(CognitoCredentialsAttrTypes()), nil
+	}
+	return types.ObjectValue(
+		CognitoCredentialsAttrTypes(),
+		CognitoCredentialsAttrValues(
+			credentials.ClientId,
+			credentials.Password,
+			credentials.UserPoolId,
+			credentials.Username,
+		),
+	)
+}
+
 func CognitoCredentialsDataSourceSchema() map[string]dataSourceSchema.Attribute {
 	return map[string]dataSourceSchema.Attribute{
 		"client_id": resourceSchema.StringAttribute{
@@ -80,3 +101,37 @@ func CognitoCredentialsResourceSchema() map[string]resourceSchema.Attribute {
 		},
 	}
 }
+
+func CognitoCredentialsEphemeralResourceSchema() map[string]ephemeralSchema.Attribute {
+	return map[string]ephemeralSchema.Attribute{
+		"client_id": ephemeralSchema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The AWS Cognito Client ID used to connect to EchoStream.",
+		},
+		"password": ephemeralSchema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The password to use when connecting to EchoStream.",
+			Sensitive:           true,
+		},
+		"user_pool_id": ephemeralSchema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The AWS Cognito User Pool ID used to connect to EchoStream.",
+		},
+		"username": ephemeralSchema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The username to use when connecting to EchoStream.",
+		},
+	}
+}
+
+// StoreCredentialsResourceAttribute returns the attribute that controls
+// whether a resource's credentials are stored in the Terraform state.
+func StoreCredentialsResourceAttribute(ephemeralResource string) resourceSchema.BoolAttribute {
+	return resourceSchema.BoolAttribute{
+		Computed: true,
+		Default:  booldefault.StaticBool(true),
+		MarkdownDescription: "Store `credentials` in the Terraform state. If `false`, `credentials` will be null and " +
+			"they may be obtained with the `" + ephemeralResource + "` ephemeral resource instead. Defaults to `true`.",
+		Optional: true,
+	}
+}
@@ -0,0 +1,280 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// maxConfigLength is the maximum length of an encoded config.
+const maxConfigLength = 4096
+
+// jsonPointerEscaper escapes a key for use in a JSON pointer.
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// decodeConfig decodes config, which must be a JSON object. Numbers are
+// decoded as json.Number so that they are encoded again unchanged.
+func decodeConfig(config string) (map[string]any, error) {
+	decoder := json.NewDecoder(strings.NewReader(config))
+	decoder.UseNumber()
+	var object map[string]any
+	if err := decoder.Decode(&object); err != nil {
+		return nil, fmt.Errorf("configs must be a JSON object:\n\n%w", err)
+	}
+	if object == nil {
+		return nil, errors.New("configs must be a JSON object, not null")
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("configs must be a single JSON object")
+	}
+	return object, nil
+}
+
+// encodeConfig encodes object compactly, with its keys sorted and without
+// escaping HTML characters.
+func encodeConfig(object map[string]any) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(object); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// checkConfigLength returns an error if the encoded config is too long.
+func checkConfigLength(config string) (string, error) {
+	if len(config) > maxConfigLength {
+		return "", fmt.Errorf("configs must be less than or equal to %d characters, the encoded config is %d", maxConfigLength, len(config))
+	}
+	return config, nil
+}
+
+// ConfigSize returns the length of config once normalized.
+func ConfigSize(config string) (int, error) {
+	if config == "" {
+		return 0, nil
+	}
+	object, err := decodeConfig(config)
+	if err != nil {
+		return 0, err
+	}
+	normalized, err := encodeConfig(object)
+	if err != nil {
+		return 0, err
+	}
+	return len(normalized), nil
+}
+
+// NormalizeConfig returns config with its keys in sorted order and without
+// insignificant whitespace. An empty config is returned unchanged.
+func NormalizeConfig(config string) (string, error) {
+	if config == "" {
+		return config, nil
+	}
+	object, err := decodeConfig(config)
+	if err != nil {
+		return "", err
+	}
+	normalized, err := encodeConfig(object)
+	if err != nil {
+		return "", err
+	}
+	return checkConfigLength(normalized)
+}
+
+// MergeConfigs deep merges configs, in order, and returns the normalized
+// result. Objects are merged key by key, and any other value replaces the
+// value before it. Replacing an object with a value that is not an object, or
+// the reverse, is a conflict. Empty configs are ignored.
+func MergeConfigs(configs ...string) (string, error) {
+	merged := map[string]any{}
+	for i, config := range configs {
+		if config == "" {
+			continue
+		}
+		object, err := decodeConfig(config)
+		if err != nil {
+			return "", &ConfigMergeError{Index: i, Err: err}
+		}
+		if err := mergeObjects(merged, object, ""); err != nil {
+			return "", &ConfigMergeError{Index: i, Err: err}
+		}
+	}
+	config, err := encodeConfig(merged)
+	if err != nil {
+		return "", err
+	}
+	return checkConfigLength(config)
+}
+
+// ConfigMergeError is returned by MergeConfigs when one of the configs cannot
+// be merged.
+type ConfigMergeError struct {
+	// Index is the position of the config that could not be merged.
+	Index int
+	Err   error
+}
+
+func (e *ConfigMergeError) Error() string {
+	return fmt.Sprintf("config %d: %s", e.Index, e.Err.Error())
+}
+
+func (e *ConfigMergeError) Unwrap() error {
+	return e.Err
+}
+
+// mergeObjects merges src into dst. pointer is the JSON pointer of dst, and
+// is used to report conflicts.
+func mergeObjects(dst map[string]any, src map[string]any, pointer string) error {
+	for key, value := range src {
+		keyPointer := pointer + "/" + jsonPointerEscaper.Replace(key)
+		existing, ok := dst[key]
+		if !ok {
+			dst[key] = value
+			continue
+		}
+		existingObject, existingIsObject := existing.(map[string]any)
+		valueObject, valueIsObject := value.(map[string]any)
+		switch {
+		case existingIsObject && valueIsObject:
+			if err := mergeObjects(existingObject, valueObject, keyPointer); err != nil {
+				return err
+			}
+		case existingIsObject != valueIsObject:
+			return fmt.Errorf("conflict at %s: cannot merge %s with %s", keyPointer, jsonKind(existing), jsonKind(value))
+		default:
+			dst[key] = value
+		}
+	}
+	return nil
+}
+
+// jsonKind returns the JSON kind of a decoded value.
+func jsonKind(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return "a string"
+	case json.Number:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return "null"
+	}
+}
+
+// ConfigsEqual returns true if a and b are the same JSON object, ignoring key
+// order and whitespace. Configs that are not JSON objects are compared as
+// strings.
+func ConfigsEqual(a string, b string) bool {
+	if a == b {
+		return true
+	}
+	aObject, err := decodeConfig(a)
+	if err != nil {
+		return false
+	}
+	bObject, err := decodeConfig(b)
+	if err != nil {
+		return false
+	}
+	return jsonEqual(aObject, bObject)
+}
+
+// jsonEqual returns true if the decoded JSON values a and b are equal.
+// Numbers are compared by value, so that 1, 1.0 and 1e0 are equal.
+func jsonEqual(a any, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, aValue := range a {
+			bValue, ok := b[key]
+			if !ok || !jsonEqual(aValue, bValue) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		aFloat, _, aErr := big.ParseFloat(a.String(), 10, 256, big.ToNearestEven)
+		bFloat, _, bErr := big.ParseFloat(b.String(), 10, 256, big.ToNearestEven)
+		if aErr != nil || bErr != nil {
+			return a == b
+		}
+		return aFloat.Cmp(bFloat) == 0
+	default:
+		return a == b
+	}
+}
+
+// ConfigDiff returns the JSON pointers of the keys that were added, removed
+// and changed from oldConfig to newConfig. Objects are compared key by key,
+// and any other value is compared as a whole.
+func ConfigDiff(oldConfig string, newConfig string) (added []string, removed []string, changed []string, err error) {
+	oldObject, newObject := map[string]any{}, map[string]any{}
+	if oldConfig != "" {
+		if oldObject, err = decodeConfig(oldConfig); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if newConfig != "" {
+		if newObject, err = decodeConfig(newConfig); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	var diff func(oldObject map[string]any, newObject map[string]any, pointer string)
+	diff = func(oldObject map[string]any, newObject map[string]any, pointer string) {
+		for key, oldValue := range oldObject {
+			keyPointer := pointer + "/" + jsonPointerEscaper.Replace(key)
+			newValue, ok := newObject[key]
+			if !ok {
+				removed = append(removed, keyPointer)
+				continue
+			}
+			oldValueObject, oldIsObject := oldValue.(map[string]any)
+			newValueObject, newIsObject := newValue.(map[string]any)
+			if oldIsObject && newIsObject {
+				diff(oldValueObject, newValueObject, keyPointer)
+			} else if !jsonEqual(oldValue, newValue) {
+				changed = append(changed, keyPointer)
+			}
+		}
+		for key := range newObject {
+			if _, ok := oldObject[key]; !ok {
+				added = append(added, pointer+"/"+jsonPointerEscaper.Replace(key))
+			}
+		}
+	}
+	diff(oldObject, newObject, "")
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+	return added, removed, changed, nil
+}
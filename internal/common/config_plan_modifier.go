@@ -0,0 +1,67 @@
+package common
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var _ planmodifier.String = configDiffPlanModifier{}
+
+// configDiffPlanModifier warns which keys of a config will change. Configs are
+// sensitive, so Terraform does not show how they differ, and the warning
+// includes only the keys and never their values.
+type configDiffPlanModifier struct {
+}
+
+// Description describes the plan modification in plain text formatting.
+func (m configDiffPlanModifier) Description(ctx context.Context) string {
+	return "Warns which keys of the config will be added, removed or changed, without their values."
+}
+
+// MarkdownDescription describes the plan modification in Markdown formatting.
+func (m configDiffPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString performs the plan modification.
+func (m configDiffPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to compare on create, destroy or if the config is not yet known
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.StateValue.ValueString() == req.PlanValue.ValueString() {
+		return
+	}
+
+	added, removed, changed, err := ConfigDiff(req.StateValue.ValueString(), req.PlanValue.ValueString())
+	if err != nil {
+		// Invalid configs are reported by ConfigType.Validate
+		return
+	}
+	if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+		// Only the key order or whitespace differs, which ConfigType treats
+		// as no change
+		return
+	}
+
+	lines := []string{"Values are not shown, as configs may contain secrets."}
+	for _, keys := range []struct {
+		heading  string
+		pointers []string
+	}{
+		{"Added", added},
+		{"Removed", removed},
+		{"Changed", changed},
+	} {
+		if len(keys.pointers) > 0 {
+			lines = append(lines, keys.heading+":\n  "+strings.Join(keys.pointers, "\n  "))
+		}
+	}
+	resp.Diagnostics.AddAttributeWarning(req.Path, "Config keys will change", strings.Join(lines, "\n\n"))
+}
+
+// ConfigDiffPlanModifier warns which keys of a config will change, without
+// their values.
+var ConfigDiffPlanModifier planmodifier.String = configDiffPlanModifier{}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &ConfigSizeFunction{}
+
+type ConfigSizeFunction struct{}
+
+func (f *ConfigSizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Returns the length of `config` once normalized, to compare with the 4096 character limit on every `config`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "The config. Must be a JSON object.",
+				Name:                "config",
+			},
+		},
+		Return:  function.Int64Return{},
+		Summary: "Compute the encoded length of a config",
+	}
+}
+
+func (f *ConfigSizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "config_size"
+}
+
+func (f *ConfigSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &config))
+	if resp.Error != nil {
+		return
+	}
+
+	size, err := ConfigSize(config)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int64(size)))
+}
@@ -0,0 +1,68 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// configTemplateUrl identifies the config_template when compiling it, and
+// appears in the errors for config_templates that are not valid JSON Schemas.
+const configTemplateUrl = "config_template.json"
+
+// ConfigViolation is a way in which a config does not match a config_template.
+type ConfigViolation struct {
+	// Pointer is the JSON pointer of the value in the config that is invalid.
+	Pointer string
+	Message string
+}
+
+// ValidateConfigTemplate returns the ways in which config does not match
+// configTemplate, a JSON Schema document. An error is returned if either is
+// not JSON, or if configTemplate is not a valid JSON Schema.
+func ValidateConfigTemplate(config string, configTemplate string) ([]ConfigViolation, error) {
+	template, err := jsonschema.UnmarshalJSON(strings.NewReader(configTemplate))
+	if err != nil {
+		return nil, fmt.Errorf("config_template is not JSON: %w", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(configTemplateUrl, template); err != nil {
+		return nil, fmt.Errorf("config_template is not a valid JSON Schema: %w", err)
+	}
+	schema, err := compiler.Compile(configTemplateUrl)
+	if err != nil {
+		return nil, fmt.Errorf("config_template is not a valid JSON Schema: %w", err)
+	}
+	instance, err := jsonschema.UnmarshalJSON(strings.NewReader(config))
+	if err != nil {
+		return nil, fmt.Errorf("config is not JSON: %w", err)
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if err := schema.Validate(instance); err == nil {
+		return nil, nil
+	} else if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+	printer := message.NewPrinter(language.English)
+	violations := []ConfigViolation{}
+	var collect func(e *jsonschema.ValidationError)
+	collect = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			pointer := ""
+			for _, token := range e.InstanceLocation {
+				pointer += "/" + jsonPointerEscaper.Replace(token)
+			}
+			violations = append(violations, ConfigViolation{Pointer: pointer, Message: e.ErrorKind.LocalizedString(printer)})
+		}
+		for _, cause := range e.Causes {
+			collect(cause)
+		}
+	}
+	collect(validationErr)
+	return violations, nil
+}
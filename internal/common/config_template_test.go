@@ -0,0 +1,45 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigTemplate(t *testing.T) {
+	t.Parallel()
+	configTemplate := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"database": {
+				"type": "object",
+				"properties": {
+					"host": {"type": "string"},
+					"port": {"type": "integer", "minimum": 1}
+				},
+				"required": ["host"]
+			},
+			"a/b": {"enum": ["x", "y"]}
+		},
+		"additionalProperties": false
+	}`
+
+	violations, err := ValidateConfigTemplate(`{"database": {"host": "db", "port": 5432}, "a/b": "x"}`, configTemplate)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+
+	violations, err = ValidateConfigTemplate(`{"database": {"port": 0}, "a/b": "z", "extra": 1}`, configTemplate)
+	require.NoError(t, err)
+	pointers := []string{}
+	for _, violation := range violations {
+		require.NotEmpty(t, violation.Message)
+		pointers = append(pointers, violation.Pointer)
+	}
+	require.ElementsMatch(t, []string{"", "/database", "/database/port", "/a~1b"}, pointers)
+
+	_, err = ValidateConfigTemplate(`{}`, `{"type": 1}`)
+	require.ErrorContains(t, err, "not a valid JSON Schema")
+	_, err = ValidateConfigTemplate(`{}`, `not json`)
+	require.ErrorContains(t, err, "not JSON")
+}
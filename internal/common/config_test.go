@@ -0,0 +1,102 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeConfig(t *testing.T) {
+	t.Parallel()
+	config, err := NormalizeConfig("{\n  \"b\": [1, 2.50, {\"d\": 1, \"c\": \"<&>\"}],\n  \"a\": 1e3\n}\n")
+	require.NoError(t, err)
+	require.Equal(t, `{"a":1e3,"b":[1,2.50,{"c":"<&>","d":1}]}`, config)
+
+	config, err = NormalizeConfig("")
+	require.NoError(t, err)
+	require.Equal(t, "", config)
+
+	for _, invalid := range []string{`[]`, `null`, `{"a": 1} {}`, `{"a": 1}}`, `{"a":`, `"a"`} {
+		_, err = NormalizeConfig(invalid)
+		require.Error(t, err, invalid)
+	}
+	_, err = NormalizeConfig(`{"a": "` + strings.Repeat("a", maxConfigLength) + `"}`)
+	require.ErrorContains(t, err, "4096")
+}
+
+func TestMergeConfigs(t *testing.T) {
+	t.Parallel()
+	config, err := MergeConfigs(
+		`{"log_level": "INFO", "db": {"host": "db", "port": 5432, "options": {"ssl": false}}, "tags": ["a"]}`,
+		"",
+		`{"log_level": "DEBUG", "db": {"host": "db.dev", "options": {"timeout": 5}}, "tags": ["b"]}`,
+	)
+	require.NoError(t, err)
+	require.Equal(t, `{"db":{"host":"db.dev","options":{"ssl":false,"timeout":5},"port":5432},"log_level":"DEBUG","tags":["b"]}`, config)
+
+	config, err = MergeConfigs()
+	require.NoError(t, err)
+	require.Equal(t, `{}`, config)
+
+	var mergeErr *ConfigMergeError
+	_, err = MergeConfigs(`{"db": {"a/b": {"host": "db"}}}`, `{"db": {"a/b": "db"}}`)
+	require.True(t, errors.As(err, &mergeErr))
+	require.Equal(t, 1, mergeErr.Index)
+	require.ErrorContains(t, err, "/db/a~1b")
+	_, err = MergeConfigs(`{}`, `{}`, `[]`)
+	require.True(t, errors.As(err, &mergeErr))
+	require.Equal(t, 2, mergeErr.Index)
+}
+
+func TestConfigSize(t *testing.T) {
+	t.Parallel()
+	size, err := ConfigSize(`{ "b": 1, "a": "` + strings.Repeat("a", maxConfigLength) + `" }`)
+	require.NoError(t, err)
+	require.Equal(t, maxConfigLength+14, size)
+	_, err = ConfigSize(`[]`)
+	require.Error(t, err)
+}
+
+func TestConfigSemanticEquals(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		a     string
+		b     string
+		equal bool
+	}{
+		{`{"a": 1, "b": {"c": [1, "2"]}}`, "{\n  \"b\": {\"c\": [1.0, \"2\"]},\n  \"a\": 1e0\n}", true},
+		{`{"a": 1}`, `{"a": 2}`, false},
+		{`{"a": [1, 2]}`, `{"a": [2, 1]}`, false},
+		{`{"a": 1}`, `{"a": 1, "b": null}`, false},
+		{`{"a": "1"}`, `{"a": 1}`, false},
+		{`not json`, `not json`, true},
+		{`not json`, `{}`, false},
+	}
+	for _, test := range tests {
+		equal, diags := ConfigValue(test.a).StringSemanticEquals(ctx, ConfigValue(test.b))
+		require.False(t, diags.HasError())
+		require.Equal(t, test.equal, equal, "%s %s", test.a, test.b)
+	}
+}
+
+func TestConfigDiff(t *testing.T) {
+	t.Parallel()
+	added, removed, changed, err := ConfigDiff(
+		`{"a": 1, "b": {"c": 1, "d": 1}, "e": [1], "f/g": 1, "h": {"i": 1}}`,
+		`{"a": 1.0, "b": {"c": 2, "x": 1}, "e": [2], "h": 1, "y": {"z": 1}}`,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/b/x", "/y"}, added)
+	require.Equal(t, []string{"/b/d", "/f~1g"}, removed)
+	require.Equal(t, []string{"/b/c", "/e", "/h"}, changed)
+
+	added, removed, changed, err = ConfigDiff("", `{"a": 1}`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/a"}, added)
+	require.Empty(t, removed)
+	require.Empty(t, changed)
+}
@@ -15,9 +15,10 @@ import (
 )
 
 var (
-	_ basetypes.StringTypable  = &ConfigType{}
-	_ xattr.TypeWithValidate   = &ConfigType{}
-	_ basetypes.StringValuable = &Config{}
+	_ basetypes.StringTypable                    = &ConfigType{}
+	_ xattr.TypeWithValidate                     = &ConfigType{}
+	_ basetypes.StringValuable                   = &Config{}
+	_ basetypes.StringValuableWithSemanticEquals = &Config{}
 )
 
 type ConfigType struct{}
@@ -75,7 +76,7 @@ func (ct ConfigType) Validate(ctx context.Context, in tftypes.Value, path path.P
 	}
 
 	if config != "" {
-		if len(config) > 4096 {
+		if len(config) > maxConfigLength {
 			diags.AddAttributeError(
 				path,
 				"Config Type Validation Error",
@@ -179,6 +180,27 @@ func (c Config) Equal(other attr.Value) bool {
 	return c.value == o.value
 }
 
+// StringSemanticEquals returns true if `newValuable` is a Config with the same
+// JSON object as `c`, ignoring key order and whitespace. This prevents
+// differences in how the EchoStream API encodes configs from causing diffs.
+func (c Config) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Config)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", c)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	return ConfigsEqual(c.value, newValue.value), diags
+}
+
 // IsNull returns true if the Config represents a null value.
 func (c Config) IsNull() bool {
 	return c.state == attr.ValueStateNull
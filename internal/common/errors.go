@@ -0,0 +1,288 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// The kinds of errors returned by the EchoStream API. Use errors.Is to test
+// an error returned by TranslateError against these.
+var (
+	ErrConflict     = errors.New("conflict")
+	ErrNotFound     = errors.New("not found")
+	ErrThrottled    = errors.New("throttled")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrValidation   = errors.New("validation error")
+)
+
+// ApiError is an error returned by the EchoStream API, classified by kind.
+type ApiError struct {
+	// Argument is the GraphQL argument or input field that the error refers
+	// to, if it could be determined.
+	Argument string
+	// ErrorType is the AppSync errorType, if one was returned.
+	ErrorType string
+	// Kind is one of the Err* kinds above, or nil if the error could not be
+	// classified.
+	Kind    error
+	Message string
+	// StatusCode is the HTTP status code if the request failed at the HTTP
+	// level rather than with a GraphQL error.
+	StatusCode int
+	err        error
+}
+
+func (e *ApiError) Error() string {
+	return e.Message
+}
+
+func (e *ApiError) Unwrap() []error {
+	var errs []error
+	if e.Kind != nil {
+		errs = append(errs, e.Kind)
+	}
+	if e.err != nil {
+		errs = append(errs, e.err)
+	}
+	return errs
+}
+
+var (
+	argumentRegexp = regexp.MustCompile(`(?i)(?:argument|variable|field|input) ['"]?([A-Za-z_][A-Za-z0-9_]*)['"]?`)
+	statusRegexp   = regexp.MustCompile(`^returned error (\d{3})[^:]*: `)
+
+	// errorTypeKinds maps AppSync errorType suffixes to kinds.
+	errorTypeKinds = []struct {
+		kind     error
+		suffixes []string
+	}{
+		{ErrUnauthorized, []string{"Unauthorized", "UnauthorizedException", "AccessDeniedException", "NotAuthorizedException"}},
+		{ErrNotFound, []string{"NotFound", "NotFoundException", "ResourceNotFoundException"}},
+		{ErrConflict, []string{"Conflict", "ConflictException", "ConditionalCheckFailedException", "AlreadyExists", "AlreadyExistsException"}},
+		{ErrThrottled, []string{"LimitExceededException", "ThrottlingException", "TooManyRequestsException"}},
+		{ErrValidation, []string{"BadRequest", "BadRequestException", "MalformedHttpRequestException", "ValidationError", "ValidationException"}},
+	}
+
+	// messageKinds are used to classify errors that have no errorType.
+	// ErrNotFound is deliberately absent, as it removes objects from the
+	// state and messages about other objects (e.g. - a missing reference)
+	// would match.
+	messageKinds = []struct {
+		kind      error
+		fragments []string
+	}{
+		{ErrUnauthorized, []string{"not authorized", "unauthorized", "access denied", "permission denied"}},
+		{ErrConflict, []string{"already exists", "in use", "conflict"}},
+		{ErrThrottled, []string{"rate exceeded", "throttl", "too many requests"}},
+		{ErrValidation, []string{"validation error", "invalid", "must be", "is required"}},
+	}
+
+	// providerArguments are GraphQL arguments that are set from the provider
+	// configuration rather than from a resource attribute.
+	providerArguments = []string{"tenant"}
+)
+
+// TranslateError converts an error returned by a genqlient operation into an
+// *ApiError. Errors that did not come from the API, such as a canceled
+// context, are returned unchanged.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) {
+		return err
+	}
+
+	var list gqlerror.List
+	if errors.As(err, &list) && len(list) > 0 {
+		return translateGqlErrors(list, err)
+	}
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		return translateGqlErrors(gqlerror.List{gqlErr}, err)
+	}
+
+	if match := statusRegexp.FindStringSubmatch(err.Error()); match != nil {
+		statusCode, _ := strconv.Atoi(match[1])
+		body := strings.TrimPrefix(err.Error(), match[0])
+		var payload struct {
+			Errors gqlerror.List `json:"errors"`
+		}
+		apiErr := &ApiError{Message: err.Error(), StatusCode: statusCode, err: err}
+		if json.Unmarshal([]byte(body), &payload) == nil && len(payload.Errors) > 0 {
+			apiErr = translateGqlErrors(payload.Errors, err).(*ApiError)
+			apiErr.StatusCode = statusCode
+		}
+		if apiErr.Kind == nil {
+			apiErr.Kind = statusKind(statusCode)
+		}
+		return apiErr
+	}
+
+	return err
+}
+
+// IsNotFound returns true if err indicates that the requested object does
+// not exist. Only a NotFound errorType or an HTTP 404 is trusted, the message
+// is not.
+func IsNotFound(err error) bool {
+	return errors.Is(TranslateError(err), ErrNotFound)
+}
+
+// ApiErrorDiagnostic returns an error diagnostic for an error returned by the
+// EchoStream API. The detail includes a remediation hint for the kind of
+// error and, where the failing argument can be determined and matches one of
+// attributes, the diagnostic is attached to that attribute.
+func ApiErrorDiagnostic(summary string, err error, attributes ...string) diag.Diagnostic {
+	detail := err.Error()
+	var apiErr *ApiError
+	if !errors.As(TranslateError(err), &apiErr) {
+		return diag.NewErrorDiagnostic(summary, detail)
+	}
+	if hint := remediation(apiErr.Kind); hint != "" {
+		detail += "\n\n" + hint
+	}
+	if apiErr.Argument != "" && !containsFold(providerArguments, apiErr.Argument) {
+		if attribute := toSnakeCase(apiErr.Argument); containsFold(attributes, attribute) {
+			return diag.NewAttributeErrorDiagnostic(path.Root(attribute), summary, detail)
+		}
+	}
+	return diag.NewErrorDiagnostic(summary, detail)
+}
+
+// translateGqlErrors classifies the GraphQL errors in list. The AppSync
+// errorType is expected in the extensions, where the provider's HTTP client
+// moves it. If the errors are of different kinds, a more specific kind is
+// preferred over ErrValidation.
+func translateGqlErrors(list gqlerror.List, err error) error {
+	messages := make([]string, 0, len(list))
+	for _, e := range list {
+		messages = append(messages, e.Message)
+	}
+	apiErr := &ApiError{Message: strings.Join(messages, "\n"), err: err}
+	for _, e := range list {
+		errorType, _ := e.Extensions["errorType"].(string)
+		kind := errorTypeKind(errorType)
+		if kind == nil {
+			kind = messageKind(e.Message)
+		}
+		if apiErr.Kind == nil || (kind != nil && apiErr.Kind == ErrValidation && kind != ErrValidation) {
+			apiErr.ErrorType = errorType
+			apiErr.Kind = kind
+		}
+		if apiErr.Argument == "" {
+			if match := argumentRegexp.FindStringSubmatch(e.Message); match != nil {
+				apiErr.Argument = match[1]
+			}
+		}
+	}
+	return apiErr
+}
+
+func errorTypeKind(errorType string) error {
+	if errorType == "" {
+		return nil
+	}
+	for _, k := range errorTypeKinds {
+		for _, suffix := range k.suffixes {
+			if strings.HasSuffix(errorType, suffix) {
+				return k.kind
+			}
+		}
+	}
+	return nil
+}
+
+func messageKind(message string) error {
+	message = strings.ToLower(message)
+	for _, k := range messageKinds {
+		for _, fragment := range k.fragments {
+			if strings.Contains(message, fragment) {
+				return k.kind
+			}
+		}
+	}
+	return nil
+}
+
+func statusKind(statusCode int) error {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusTooManyRequests:
+		return ErrThrottled
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	}
+	return nil
+}
+
+func remediation(kind error) string {
+	switch kind {
+	case ErrUnauthorized:
+		return "Check that the provider credentials are correct and that the user's role in the Tenant permits this operation."
+	case ErrNotFound:
+		return "The object may have been deleted outside of Terraform. Check the name and Tenant, or run terraform apply -refresh-only to reconcile the state."
+	case ErrConflict:
+		return "An object with the same name may already exist or the object may be in use. Choose a different name, import the existing object with terraform import, or remove the objects that depend on it."
+	case ErrThrottled:
+		return "The request was throttled by the EchoStream API after all retries. Reduce terraform -parallelism, lower requests_per_second, or raise max_retries in the provider configuration."
+	case ErrValidation:
+		return "Check the value against the requirements in the EchoStream documentation."
+	}
+	return ""
+}
+
+// AttributeNames returns the names of the attributes of value, the raw value
+// of a plan, state or config, for use with ApiErrorDiagnostic.
+func AttributeNames(value tftypes.Value) []string {
+	object, ok := value.Type().(tftypes.Object)
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(object.AttributeTypes))
+	for name := range object.AttributeTypes {
+		names = append(names, name)
+	}
+	return names
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// toSnakeCase converts a GraphQL argument name, such as maxReceiveCount, to
+// the corresponding attribute name, such as max_receive_count.
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,87 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func gqlErrors(errorType string, message string) error {
+	e := &gqlerror.Error{Message: message}
+	if errorType != "" {
+		e.Extensions = map[string]any{"errorType": errorType}
+	}
+	return gqlerror.List{e}
+}
+
+func TestTranslateError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		err  error
+		kind error
+	}{
+		{gqlErrors("Unauthorized", "Not Authorized to access createNode on type Mutation"), ErrUnauthorized},
+		{gqlErrors("", "Not Authorized to access getNode on type Query"), ErrUnauthorized},
+		{gqlErrors("NotFoundException", "Node foo not found"), ErrNotFound},
+		{gqlErrors("", "Node foo does not exist"), nil},
+		{gqlErrors("", "MessageType bar not found"), nil},
+		{gqlErrors("DynamoDB:ConditionalCheckFailedException", "The conditional request failed"), ErrConflict},
+		{gqlErrors("", "Node foo already exists"), ErrConflict},
+		{gqlErrors("ValidationError", "Validation error of type WrongType: argument 'maxReceiveCount' with value 'StringValue{value='a'}' is not a valid 'Int'"), ErrValidation},
+		{gqlErrors("ThrottlingException", "Rate exceeded"), ErrThrottled},
+		{gqlErrors("Lambda:Unhandled", "something went wrong"), nil},
+		{errors.New(`returned error 401 Unauthorized: {"errors":[{"errorType":"UnauthorizedException","message":"Valid authorization header not provided."}]}`), ErrUnauthorized},
+		{errors.New(`returned error 404 Not Found: `), ErrNotFound},
+		{fmt.Errorf("wrapped: %w", gqlErrors("NotFound", "gone")), ErrNotFound},
+	}
+	for _, test := range tests {
+		err := TranslateError(test.err)
+		var apiErr *ApiError
+		require.ErrorAs(t, err, &apiErr, test.err.Error())
+		require.Equal(t, test.kind, apiErr.Kind, test.err.Error())
+		if test.kind != nil {
+			require.ErrorIs(t, err, test.kind)
+		}
+	}
+
+	require.Nil(t, TranslateError(nil))
+	require.Equal(t, context.Canceled, TranslateError(context.Canceled))
+	require.True(t, IsNotFound(gqlErrors("ResourceNotFoundException", "KmsKey foo not found")))
+	require.False(t, IsNotFound(gqlErrors("", "KmsKey foo not found")))
+	require.False(t, IsNotFound(gqlErrors("ValidationError", "Edge source foo does not exist")))
+	require.False(t, IsNotFound(context.Canceled))
+}
+
+func TestApiErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+	invalidArgument := gqlErrors("ValidationError", "Validation error of type WrongType: argument 'maxReceiveCount' with value 'StringValue{value='a'}' is not a valid 'Int'")
+	d := ApiErrorDiagnostic("Error creating Edge", invalidArgument, "max_receive_count", "source", "target")
+	require.Equal(t, diag.SeverityError, d.Severity())
+	require.Equal(t, "Error creating Edge", d.Summary())
+	require.Contains(t, d.Detail(), "EchoStream documentation")
+	withPath, ok := d.(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	require.Equal(t, path.Root("max_receive_count"), withPath.Path())
+
+	// Arguments set from the provider configuration have no attribute
+	d = ApiErrorDiagnostic("Error reading Node", gqlErrors("", "Variable 'tenant' has an invalid value"), "name", "tenant")
+	_, ok = d.(diag.DiagnosticWithPath)
+	require.False(t, ok)
+
+	// Neither do arguments and fields that are not attributes
+	d = ApiErrorDiagnostic("Error creating Edge", gqlErrors("", "Field 'getNode' in type 'Query' is undefined"), "max_receive_count")
+	_, ok = d.(diag.DiagnosticWithPath)
+	require.False(t, ok)
+	d = ApiErrorDiagnostic("Error creating Edge", invalidArgument)
+	_, ok = d.(diag.DiagnosticWithPath)
+	require.False(t, ok)
+
+	d = ApiErrorDiagnostic("Error reading Node", errors.New("connection refused"))
+	require.Equal(t, "connection refused", d.Detail())
+}
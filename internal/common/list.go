@@ -0,0 +1,203 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+)
+
+// ListedNode is the fields of a listed Node that are common to all Node types.
+type ListedNode struct {
+	// The App the Node is associated with, if any
+	App                *string
+	Description        *string
+	Name               string
+	ReceiveMessageType *string
+	SendMessageType    *string
+	// The GraphQL type name of the Node (e.g. - ProcessorNode)
+	Type string
+}
+
+// ListApps returns all of the Apps in the Tenant, reading every page.
+func ListApps(ctx context.Context, data *ProviderData) ([]api.ListAppsListAppsAppConnectionAppsApp, error) {
+	var (
+		apps      []api.ListAppsListAppsAppConnectionAppsApp
+		nextToken *string
+	)
+	for {
+		echoResp, err := api.ListApps(ctx, data.Client, data.Tenant, nextToken)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, echoResp.ListApps.Apps...)
+		if nextToken = echoResp.ListApps.NextToken; nextToken == nil {
+			return apps, nil
+		}
+	}
+}
+
+// ListEdges returns all of the Edges in the Tenant, reading every page.
+func ListEdges(ctx context.Context, data *ProviderData) ([]*api.EdgeFields, error) {
+	var (
+		edges     []*api.EdgeFields
+		nextToken *string
+	)
+	for {
+		echoResp, err := api.ListEdges(ctx, data.Client, data.Tenant, nextToken)
+		if err != nil {
+			return nil, err
+		}
+		for i := range echoResp.ListEdges.Edges {
+			edges = append(edges, &echoResp.ListEdges.Edges[i].EdgeFields)
+		}
+		if nextToken = echoResp.ListEdges.NextToken; nextToken == nil {
+			return edges, nil
+		}
+	}
+}
+
+// ListNodes returns all of the Nodes in the Tenant, reading every page.
+func ListNodes(ctx context.Context, data *ProviderData) ([]*ListedNode, error) {
+	var (
+		nodes     []*ListedNode
+		nextToken *string
+	)
+	for {
+		echoResp, err := api.ListNodes(ctx, data.Client, data.Tenant, nextToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range echoResp.ListNodes.Nodes {
+			n, err := newListedNode(node)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, n)
+		}
+		if nextToken = echoResp.ListNodes.NextToken; nextToken == nil {
+			return nodes, nil
+		}
+	}
+}
+
+func newListedNode(node api.ListNodesListNodesNodeConnectionNodesNode) (*ListedNode, error) {
+	n := &ListedNode{
+		Description: node.GetDescription(),
+		Name:        node.GetName(),
+	}
+	if node.GetTypename() != nil {
+		n.Type = *node.GetTypename()
+	}
+	switch node := node.(type) {
+	case *api.ListNodesListNodesNodeConnectionNodesAlertEmitterNode:
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesAppChangeReceiverNode:
+		if node.App != nil {
+			app := node.App.GetName()
+			n.App = &app
+		}
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesAppChangeRouterNode:
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesAuditEmitterNode:
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesBitmapRouterNode:
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesChangeEmitterNode:
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesCrossTenantReceivingNode:
+		n.App = &node.App.Name
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesCrossTenantSendingNode:
+		n.App = &node.App.Name
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesDeadLetterEmitterNode:
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesExternalNode:
+		switch app := (node.App).(type) {
+		case *api.ListNodesListNodesNodeConnectionNodesExternalNodeAppCrossAccountApp:
+			n.App = &app.Name
+		case *api.ListNodesListNodesNodeConnectionNodesExternalNodeAppExternalApp:
+			n.App = &app.Name
+		default:
+			return nil, fmt.Errorf("expected CrossAccountApp or ExternalApp for '%s', got %T", n.Name, app)
+		}
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesFilesDotComWebhookNode:
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesLoadBalancerNode:
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesLogEmitterNode:
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesManagedNode:
+		n.App = &node.App.Name
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesProcessorNode:
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesTimerNode:
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesWebhookNode:
+		if node.SendMessageType != nil {
+			n.SendMessageType = &node.SendMessageType.Name
+		}
+	case *api.ListNodesListNodesNodeConnectionNodesWebSubHubNode:
+		if node.ReceiveMessageType != nil {
+			n.ReceiveMessageType = &node.ReceiveMessageType.Name
+		}
+	}
+	return n, nil
+}
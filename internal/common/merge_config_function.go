@@ -0,0 +1,54 @@
+package common
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &MergeConfigFunction{}
+
+type MergeConfigFunction struct{}
+
+func (f *MergeConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Deep merges `configs`, in order, and returns the normalized result. Objects are merged key by key, " +
+			"and any other value replaces the value before it. Replacing an object with a value that is not an object, " +
+			"or the reverse, is a conflict and an error. Empty configs are ignored. The result must be less than or equal " +
+			"to 4096 characters, as required of every `config`.",
+		Return:  function.StringReturn{},
+		Summary: "Deep merge configs",
+		VariadicParameter: function.StringParameter{
+			MarkdownDescription: "The configs to merge. Each must be a JSON object.",
+			Name:                "configs",
+		},
+	}
+}
+
+func (f *MergeConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_config"
+}
+
+func (f *MergeConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var configs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &configs))
+	if resp.Error != nil {
+		return
+	}
+
+	merged, err := MergeConfigs(configs...)
+	if err != nil {
+		var mergeErr *ConfigMergeError
+		if errors.As(err, &mergeErr) {
+			resp.Error = function.NewArgumentFuncError(int64(mergeErr.Index), err.Error())
+		} else {
+			resp.Error = function.NewFuncError(err.Error())
+		}
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, merged))
+}
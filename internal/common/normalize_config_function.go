@@ -0,0 +1,48 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &NormalizeConfigFunction{}
+
+type NormalizeConfigFunction struct{}
+
+func (f *NormalizeConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Returns `config` with its keys sorted and without insignificant whitespace. " +
+			"The result must be a JSON object of less than or equal to 4096 characters, as required of every `config`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "The config. Must be a JSON object.",
+				Name:                "config",
+			},
+		},
+		Return:  function.StringReturn{},
+		Summary: "Normalize a config",
+	}
+}
+
+func (f *NormalizeConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_config"
+}
+
+func (f *NormalizeConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &config))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := NormalizeConfig(config)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,478 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	minScheduleYear = 1970
+	maxScheduleYear = 2199
+)
+
+var (
+	cronExpressionRegex = regexp.MustCompile(`^cron\((.*)\)$`)
+	rateExpressionRegex = regexp.MustCompile(`^rate\((.*)\)$`)
+	rateRegex           = regexp.MustCompile(`^([0-9]+) ([a-z]+)$`)
+	rateUnits           = map[string]time.Duration{
+		"minute":  time.Minute,
+		"minutes": time.Minute,
+		"hour":    time.Hour,
+		"hours":   time.Hour,
+		"day":     24 * time.Hour,
+		"days":    24 * time.Hour,
+	}
+
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	weekdayNames = map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}
+	// daysInMonth is the most days that each month can have.
+	daysInMonth = [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+)
+
+// ScheduleError is returned by ParseSchedule for an invalid schedule
+// expression. Field is the name of the field that is invalid.
+type ScheduleError struct {
+	Field   string
+	Message string
+	Value   string
+}
+
+func (e *ScheduleError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("%s %q: %s", e.Field, e.Value, e.Message)
+}
+
+// Schedule is a parsed Amazon EventBridge schedule expression, either a cron
+// expression or a rate expression.
+type Schedule struct {
+	cron *cronSchedule
+	rate time.Duration
+}
+
+// Warnings returns the deprecated usages in the schedule expression, which
+// are accepted but should be changed.
+func (s *Schedule) Warnings() []string {
+	var warnings []string
+	if s.cron != nil && s.cron.dayOfWeek.zeroSunday {
+		warnings = append(warnings, "day_of_week is numbered from 1 (SUN) to 7 (SAT), and using 0 for Sunday is deprecated. "+
+			"Use 1 or SUN instead, and check that any other numbered days in day_of_week are the intended days.")
+	}
+	return warnings
+}
+
+// ParseSchedule parses an Amazon EventBridge schedule expression. Cron
+// expressions may be given with or without the surrounding "cron(...)".
+func ParseSchedule(expression string) (*Schedule, error) {
+	if match := rateExpressionRegex.FindStringSubmatch(expression); match != nil {
+		rate, err := parseRate(match[1])
+		if err != nil {
+			return nil, err
+		}
+		return &Schedule{rate: rate}, nil
+	}
+	if match := cronExpressionRegex.FindStringSubmatch(expression); match != nil {
+		expression = match[1]
+	}
+	cron, err := parseCron(expression)
+	if err != nil {
+		return nil, err
+	}
+	return &Schedule{cron: cron}, nil
+}
+
+// Next returns up to count times that the schedule fires after from, in UTC.
+// Rate expressions fire relative to when their rule was created, so they are
+// assumed to have been created at from.
+func (s *Schedule) Next(from time.Time, count int) []time.Time {
+	times := []time.Time{}
+	from = from.UTC()
+	for len(times) < count {
+		if s.rate > 0 {
+			from = from.Add(s.rate)
+		} else {
+			next, ok := s.cron.next(from)
+			if !ok {
+				break
+			}
+			from = next
+		}
+		times = append(times, from)
+	}
+	return times
+}
+
+func parseRate(rate string) (time.Duration, error) {
+	match := rateRegex.FindStringSubmatch(rate)
+	if match == nil {
+		return 0, &ScheduleError{Field: "rate", Message: "must be a value and a unit (e.g. - \"5 minutes\")", Value: rate}
+	}
+	value, err := strconv.Atoi(match[1])
+	if err != nil || value < 1 {
+		return 0, &ScheduleError{Field: "rate", Message: "value must be a positive integer", Value: match[1]}
+	}
+	unit, ok := rateUnits[match[2]]
+	if !ok {
+		return 0, &ScheduleError{Field: "rate", Message: "unit must be one of minute, minutes, hour, hours, day or days", Value: match[2]}
+	}
+	if plural := strings.HasSuffix(match[2], "s"); plural != (value > 1) {
+		if plural {
+			return 0, &ScheduleError{Field: "rate", Message: "unit must be singular for a value of 1", Value: match[2]}
+		}
+		return 0, &ScheduleError{Field: "rate", Message: "unit must be plural for a value greater than 1", Value: match[2]}
+	}
+	return time.Duration(value) * unit, nil
+}
+
+// cronField describes one of the fields of a cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+	// aliases are deprecated numbers that are accepted for other numbers.
+	aliases map[int]int
+}
+
+var (
+	minutesField    = cronField{name: "minutes", min: 0, max: 59}
+	hoursField      = cronField{name: "hours", min: 0, max: 23}
+	dayOfMonthField = cronField{name: "day_of_month", min: 1, max: 31}
+	monthField      = cronField{name: "month", min: 1, max: 12, names: monthNames}
+	dayOfWeekField  = cronField{name: "day_of_week", min: 1, max: 7, names: weekdayNames, aliases: map[int]int{0: 1}}
+	yearField       = cronField{name: "year", min: minScheduleYear, max: maxScheduleYear}
+)
+
+func (f cronField) error(value string, format string, a ...any) error {
+	return &ScheduleError{Field: f.name, Message: fmt.Sprintf(format, a...), Value: value}
+}
+
+// parseValue parses a single number or name in the field.
+func (f cronField) parseValue(value string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		if f.names != nil {
+			return 0, f.error(value, "must be a number between %d and %d or a name", f.min, f.max)
+		}
+		return 0, f.error(value, "must be a number between %d and %d", f.min, f.max)
+	}
+	if alias, ok := f.aliases[n]; ok {
+		return alias, nil
+	}
+	if n < f.min || n > f.max {
+		return 0, f.error(value, "must be between %d and %d", f.min, f.max)
+	}
+	return n, nil
+}
+
+// parse parses a list of values, ranges, wildcards and increments into the
+// set of values that the field matches.
+func (f cronField) parse(field string) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return nil, f.error(item, "increment must be a positive integer")
+			}
+		}
+		start, end := f.min, f.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			first, last, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = f.parseValue(first); err != nil {
+				return nil, err
+			}
+			if end, err = f.parseValue(last); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, f.error(item, "range must not end before it starts")
+			}
+		default:
+			var err error
+			if start, err = f.parseValue(rangePart); err != nil {
+				return nil, err
+			}
+			if !hasStep {
+				end = start
+			}
+		}
+		for value := start; value <= end; value += step {
+			values[value] = true
+		}
+	}
+	return values, nil
+}
+
+// cronSchedule is a parsed cron expression. Exactly one of dayOfMonth and
+// dayOfWeek is used to match days.
+type cronSchedule struct {
+	minutes map[int]bool
+	hours   map[int]bool
+	months  map[int]bool
+	years   map[int]bool
+
+	useDayOfWeek bool
+	dayOfMonth   dayOfMonthSpec
+	dayOfWeek    dayOfWeekSpec
+}
+
+// dayOfMonthSpec is a parsed day_of_month field.
+type dayOfMonthSpec struct {
+	days map[int]bool
+	// last is set for "L" and "L-n", where lastOffset is n.
+	last       bool
+	lastOffset int
+	// lastWeekday is set for "LW".
+	lastWeekday bool
+	// nearestWeekday is n for "nW".
+	nearestWeekday int
+}
+
+// dayOfWeekSpec is a parsed day_of_week field. Days are numbered from 1 for
+// Sunday to 7 for Saturday.
+type dayOfWeekSpec struct {
+	days map[int]bool
+	// last is n for "nL", the last of that day in the month.
+	last int
+	// nth is n and occurrence is k for "n#k", the kth of that day in the month.
+	nth        int
+	occurrence int
+	// zeroSunday is set if the deprecated 0 was used for Sunday.
+	zeroSunday bool
+}
+
+var (
+	lastDayOfMonthRegex = regexp.MustCompile(`^L(?:-([0-9]+))?$`)
+	nearestWeekdayRegex = regexp.MustCompile(`^([0-9]+)W$`)
+	lastDayOfWeekRegex  = regexp.MustCompile(`^([0-9A-Za-z]+)L$`)
+	nthDayOfWeekRegex   = regexp.MustCompile(`^([0-9A-Za-z]+)#([0-9]+)$`)
+	zeroSundayRegex     = regexp.MustCompile(`(?:^|[,-])0+(?:$|[,\-/#L])`)
+)
+
+func parseCron(expression string) (*cronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 6 {
+		return nil, &ScheduleError{
+			Field:   "expression",
+			Message: fmt.Sprintf("must have 6 fields (minutes, hours, day_of_month, month, day_of_week and year), found %d", len(fields)),
+			Value:   expression,
+		}
+	}
+	var (
+		c   cronSchedule
+		err error
+	)
+	if c.minutes, err = minutesField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if c.hours, err = hoursField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if c.months, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if c.years, err = yearField.parse(fields[5]); err != nil {
+		return nil, err
+	}
+	switch dayOfMonth, dayOfWeek := fields[2], fields[4]; {
+	case dayOfMonth == "?" && dayOfWeek == "?":
+		return nil, &ScheduleError{Field: "day_of_week", Message: "only one of day_of_month and day_of_week may be '?'", Value: dayOfWeek}
+	case dayOfMonth != "?" && dayOfWeek != "?":
+		return nil, &ScheduleError{
+			Field:   "day_of_week",
+			Message: "day_of_month and day_of_week cannot both be set, one of them must be '?'",
+			Value:   dayOfWeek,
+		}
+	case dayOfWeek == "?":
+		if c.dayOfMonth, err = parseDayOfMonth(dayOfMonth); err != nil {
+			return nil, err
+		}
+		if err = c.checkDaysOfMonthOccur(dayOfMonth); err != nil {
+			return nil, err
+		}
+	default:
+		c.useDayOfWeek = true
+		if c.dayOfWeek, err = parseDayOfWeek(dayOfWeek); err != nil {
+			return nil, err
+		}
+	}
+	return &c, nil
+}
+
+func parseDayOfMonth(field string) (dayOfMonthSpec, error) {
+	var (
+		spec dayOfMonthSpec
+		err  error
+	)
+	switch {
+	case field == "LW":
+		spec.lastWeekday = true
+	case lastDayOfMonthRegex.MatchString(field):
+		spec.last = true
+		if offset := lastDayOfMonthRegex.FindStringSubmatch(field)[1]; offset != "" {
+			if spec.lastOffset, err = strconv.Atoi(offset); err != nil || spec.lastOffset > 30 {
+				return spec, dayOfMonthField.error(field, "offset from the last day must be between 0 and 30")
+			}
+		}
+	case nearestWeekdayRegex.MatchString(field):
+		if spec.nearestWeekday, err = dayOfMonthField.parseValue(nearestWeekdayRegex.FindStringSubmatch(field)[1]); err != nil {
+			return spec, err
+		}
+	default:
+		if strings.ContainsAny(field, "LW") {
+			return spec, dayOfMonthField.error(field, "'L' and 'W' must be used alone (e.g. - \"L\", \"L-2\", \"LW\" or \"15W\")")
+		}
+		spec.days, err = dayOfMonthField.parse(field)
+	}
+	return spec, err
+}
+
+func parseDayOfWeek(field string) (dayOfWeekSpec, error) {
+	var (
+		spec dayOfWeekSpec
+		err  error
+	)
+	spec.zeroSunday = zeroSundayRegex.MatchString(field)
+	switch {
+	case nthDayOfWeekRegex.MatchString(field):
+		match := nthDayOfWeekRegex.FindStringSubmatch(field)
+		if spec.nth, err = dayOfWeekField.parseValue(match[1]); err != nil {
+			return spec, err
+		}
+		if spec.occurrence, err = strconv.Atoi(match[2]); err != nil || spec.occurrence < 1 || spec.occurrence > 5 {
+			return spec, dayOfWeekField.error(field, "occurrence after '#' must be between 1 and 5")
+		}
+	case lastDayOfWeekRegex.MatchString(field):
+		if spec.last, err = dayOfWeekField.parseValue(lastDayOfWeekRegex.FindStringSubmatch(field)[1]); err != nil {
+			return spec, err
+		}
+	default:
+		if strings.Contains(field, "#") {
+			return spec, dayOfWeekField.error(field, "'#' must be used alone (e.g. - \"MON#2\")")
+		}
+		spec.days, err = dayOfWeekField.parse(field)
+	}
+	return spec, err
+}
+
+// checkDaysOfMonthOccur returns an error if none of the days in the
+// day_of_month field occur in any of the months in the month field (e.g. -
+// the 30th of February), or the years in the year field (e.g. - the 29th of
+// February 2025).
+func (c *cronSchedule) checkDaysOfMonthOccur(field string) error {
+	if c.dayOfMonth.days == nil && c.dayOfMonth.nearestWeekday == 0 {
+		return nil
+	}
+	first := 31
+	if c.dayOfMonth.nearestWeekday != 0 {
+		first = c.dayOfMonth.nearestWeekday
+	}
+	for day := range c.dayOfMonth.days {
+		first = min(first, day)
+	}
+	for month := range c.months {
+		if first <= c.maxDaysInMonth(month) {
+			return nil
+		}
+	}
+	return dayOfMonthField.error(field, "never occurs in the months of the month field and the years of the year field")
+}
+
+// maxDaysInMonth returns the most days that month has in the years of the
+// year field.
+func (c *cronSchedule) maxDaysInMonth(month int) int {
+	if month != int(time.February) {
+		return daysInMonth[month]
+	}
+	for year := range c.years {
+		if time.Date(year, time.March, 0, 0, 0, 0, 0, time.UTC).Day() == 29 {
+			return 29
+		}
+	}
+	return 28
+}
+
+// next returns the first time after from that the schedule fires.
+func (c *cronSchedule) next(from time.Time) (time.Time, bool) {
+	t := from.Truncate(time.Minute).Add(time.Minute)
+	for t.Year() <= maxScheduleYear {
+		switch {
+		case !c.years[t.Year()]:
+			t = time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		case !c.months[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !c.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+		case !c.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (c *cronSchedule) matchesDay(t time.Time) bool {
+	day := t.Day()
+	lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if c.useDayOfWeek {
+		weekday := int(t.Weekday()) + 1
+		switch s := c.dayOfWeek; {
+		case s.last != 0:
+			return weekday == s.last && day+7 > lastDay
+		case s.nth != 0:
+			return weekday == s.nth && (day-1)/7+1 == s.occurrence
+		default:
+			return s.days[weekday]
+		}
+	}
+	switch s := c.dayOfMonth; {
+	case s.last:
+		return day == lastDay-s.lastOffset
+	case s.lastWeekday:
+		return day == nearestWeekday(t.Year(), t.Month(), lastDay, lastDay)
+	case s.nearestWeekday != 0:
+		return s.nearestWeekday <= lastDay && day == nearestWeekday(t.Year(), t.Month(), s.nearestWeekday, lastDay)
+	default:
+		return s.days[day]
+	}
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest to day,
+// without leaving the month.
+func nearestWeekday(year int, month time.Month, day int, lastDay int) int {
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
@@ -0,0 +1,138 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScheduleErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		expression string
+		field      string
+	}{
+		{"0 12 * *", "expression"},
+		{"60 12 * * ? *", "minutes"},
+		{"0 24 * * ? *", "hours"},
+		{"0 12 32 * ? *", "day_of_month"},
+		{"0 12 30 FEB ? *", "day_of_month"},
+		{"0 0 29 2 ? 2025", "day_of_month"},
+		{"0 0 29W 2 ? 2025-2027", "day_of_month"},
+		{"0 12 31 APR,JUN ? *", "day_of_month"},
+		{"0 12 1W,2 * ? *", "day_of_month"},
+		{"0 12 * FOO ? *", "month"},
+		{"0 12 * * MON *", "day_of_week"},
+		{"0 12 ? * ? *", "day_of_week"},
+		{"0 12 ? * 8 *", "day_of_week"},
+		{"0 12 ? * MON#6 *", "day_of_week"},
+		{"0 12 * * ? 1969", "year"},
+		{"0 12-10 * * ? *", "hours"},
+		{"*/0 12 * * ? *", "minutes"},
+		{"rate(0 minutes)", "rate"},
+		{"rate(1 minutes)", "rate"},
+		{"rate(5 minute)", "rate"},
+		{"rate(5 weeks)", "rate"},
+		{"rate(5)", "rate"},
+	}
+	for _, test := range tests {
+		_, err := ParseSchedule(test.expression)
+		var scheduleErr *ScheduleError
+		require.True(t, errors.As(err, &scheduleErr), test.expression)
+		require.Equal(t, test.field, scheduleErr.Field, test.expression)
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2024, time.January, 30, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expression string
+		next       []string
+	}{
+		{"rate(5 minutes)", []string{"2024-01-30T12:05:00Z", "2024-01-30T12:10:00Z"}},
+		{"rate(1 day)", []string{"2024-01-31T12:00:00Z", "2024-02-01T12:00:00Z"}},
+		{"cron(0/15 * * * ? *)", []string{"2024-01-30T12:15:00Z", "2024-01-30T12:30:00Z"}},
+		{"0 10 * * ? *", []string{"2024-01-31T10:00:00Z", "2024-02-01T10:00:00Z"}},
+		{"0 18 ? * MON-FRI *", []string{"2024-01-30T18:00:00Z", "2024-01-31T18:00:00Z", "2024-02-01T18:00:00Z", "2024-02-02T18:00:00Z", "2024-02-05T18:00:00Z"}},
+		{"0 8 L * ? *", []string{"2024-01-31T08:00:00Z", "2024-02-29T08:00:00Z", "2024-03-31T08:00:00Z"}},
+		{"0 8 L-1 * ? *", []string{"2024-02-28T08:00:00Z", "2024-03-30T08:00:00Z"}},
+		{"0 8 LW * ? *", []string{"2024-01-31T08:00:00Z", "2024-02-29T08:00:00Z", "2024-03-29T08:00:00Z"}},
+		{"0 8 1W * ? *", []string{"2024-02-01T08:00:00Z", "2024-03-01T08:00:00Z", "2024-04-01T08:00:00Z", "2024-05-01T08:00:00Z", "2024-06-03T08:00:00Z"}},
+		{"0 8 ? * 6L *", []string{"2024-02-23T08:00:00Z", "2024-03-29T08:00:00Z"}},
+		{"0 8 ? * MON#1 *", []string{"2024-02-05T08:00:00Z", "2024-03-04T08:00:00Z"}},
+		{"0 8 ? * 0,SAT *", []string{"2024-02-03T08:00:00Z", "2024-02-04T08:00:00Z", "2024-02-10T08:00:00Z"}},
+		{"0 0 29 FEB ? *", []string{"2024-02-29T00:00:00Z", "2028-02-29T00:00:00Z"}},
+		{"0 0 29 1,2 ? 2025", []string{"2025-01-29T00:00:00Z"}},
+		{"0 0 29 2 ? 2025-2028", []string{"2028-02-29T00:00:00Z"}},
+		{"0 0 1 1 ? 2024-2025", []string{"2025-01-01T00:00:00Z"}},
+	}
+
+	// Schedules that stop firing return fewer times
+	schedule, err := ParseSchedule("0 0 1 1 ? 2024-2025")
+	require.NoError(t, err)
+	require.Len(t, schedule.Next(from, 2), 1)
+	for _, test := range tests {
+		schedule, err := ParseSchedule(test.expression)
+		require.NoError(t, err, test.expression)
+		next := []string{}
+		for _, t := range schedule.Next(from, len(test.next)) {
+			next = append(next, t.Format(time.RFC3339))
+		}
+		require.Equal(t, test.next, next, test.expression)
+	}
+}
+
+func TestScheduleWarnings(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		expression string
+		deprecated bool
+	}{
+		{"0 8 ? * 0 *", true},
+		{"0 8 ? * 0-5 *", true},
+		{"0 8 ? * MON,0 *", true},
+		{"0 8 ? * 0#2 *", true},
+		{"0 8 ? * 1-7 *", false},
+		{"0 8 ? * 1#2 *", false},
+		{"0 10 * * ? *", false},
+		{"rate(5 minutes)", false},
+	}
+	for _, test := range tests {
+		schedule, err := ParseSchedule(test.expression)
+		require.NoError(t, err, test.expression)
+		require.Equal(t, test.deprecated, len(schedule.Warnings()) > 0, test.expression)
+	}
+}
+
+func TestScheduleExpressionValidator(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		expression string
+		summaries  []string
+	}{
+		{"0 10 * * ? *", nil},
+		{"0 12 30 FEB ? *", []string{"Invalid day_of_month in schedule expression"}},
+		{"0 0 ? 2 6#5 2027", []string{"Schedule expression never fires"}},
+		{"0 0 1 1 ? 1970", []string{"Schedule expression never fires"}},
+		{"0 8 ? * 0 *", []string{"Deprecated day_of_week in schedule expression"}},
+	}
+	for _, test := range tests {
+		resp := &validator.StringResponse{}
+		ScheduleExpressionValidator.ValidateString(context.Background(), validator.StringRequest{
+			ConfigValue: types.StringValue(test.expression),
+			Path:        path.Root("schedule_expression"),
+		}, resp)
+		var summaries []string
+		for _, diagnostic := range resp.Diagnostics {
+			summaries = append(summaries, diagnostic.Summary())
+		}
+		require.Equal(t, test.summaries, summaries, test.expression)
+	}
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = scheduleExpressionValidator{}
+
+// scheduleExpressionValidator validates that value is an Amazon EventBridge
+// schedule expression.
+type scheduleExpressionValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (v scheduleExpressionValidator) Description(ctx context.Context) string {
+	return "Value must be an Amazon EventBridge cron or rate expression."
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v scheduleExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v scheduleExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	schedule, err := ParseSchedule(req.ConfigValue.ValueString())
+	if err != nil {
+		summary := "Invalid schedule expression"
+		var scheduleErr *ScheduleError
+		if errors.As(err, &scheduleErr) {
+			summary = "Invalid " + scheduleErr.Field + " in schedule expression"
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			summary,
+			err.Error()+"\n\nSee https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html",
+		)
+		return
+	}
+	for _, warning := range schedule.Warnings() {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Deprecated day_of_week in schedule expression", warning)
+	}
+	// Some schedules are valid but never fire again (e.g. - the fifth Friday
+	// of February 2025, or a year that has passed)
+	if len(schedule.Next(time.Now(), 1)) == 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Schedule expression never fires",
+			"The schedule expression does not fire at any time in the future, so next_fire_times will be empty.",
+		)
+	}
+}
+
+// ScheduleExpressionValidator ensures that any configured attribute value is
+// an Amazon EventBridge schedule expression parseable by ParseSchedule.
+var ScheduleExpressionValidator validator.String = scheduleExpressionValidator{}
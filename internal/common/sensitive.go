@@ -0,0 +1,30 @@
+package common
+
+import "strings"
+
+// SensitiveKeys are the GraphQL variable and field names whose values are
+// never logged or recorded. Keys are compared case-insensitively.
+var SensitiveKeys = []string{
+	"accessToken",
+	"apiKey",
+	"config",
+	"credentials",
+	"idToken",
+	"newPassword",
+	"password",
+	"refreshToken",
+	"secretAccessKey",
+	"sessionToken",
+	"token",
+	"totpSecret",
+}
+
+// IsSensitiveKey returns true if key is one of the SensitiveKeys.
+func IsSensitiveKey(key string) bool {
+	for _, sensitive := range SensitiveKeys {
+		if strings.EqualFold(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
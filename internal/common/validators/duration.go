@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that value is a valid Go duration string.
+type durationValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (v durationValidator) Description(ctx context.Context) string {
+	return "Value must be a valid duration (e.g. - \"30s\", \"2m\", \"1h30m\")."
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	s := req.ConfigValue.ValueString()
+
+	if d, err := time.ParseDuration(s); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Expected valid duration",
+			err.Error(),
+		)
+	} else if d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Expected non-negative duration",
+			s,
+		)
+	}
+}
+
+// Duration returns a validator which ensures that any configured
+// attribute value is a non-negative duration parseable by time.ParseDuration.
+func Duration() validator.String {
+	return durationValidator{}
+}
@@ -0,0 +1,45 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = regexpValidator{}
+
+// regexpValidator validates that value is a valid Go regular expression.
+type regexpValidator struct {
+}
+
+// Description describes the validation in plain text formatting.
+func (v regexpValidator) Description(ctx context.Context) string {
+	return "Value must be a valid regular expression (RE2 syntax)."
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v regexpValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a valid regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax))."
+}
+
+// Validate performs the validation.
+func (v regexpValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Expected valid regular expression",
+			err.Error(),
+		)
+	}
+}
+
+// Regexp returns a validator which ensures that any configured
+// attribute value is a regular expression parseable by regexp.Compile.
+func Regexp() validator.String {
+	return regexpValidator{}
+}
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const (
+	DefaultDeleteTimeout = 10 * time.Minute
+	DefaultUpdateTimeout = 10 * time.Minute
+	maxPollInterval      = 5 * time.Second
+	minPollInterval      = 250 * time.Millisecond
+)
+
+// TimeoutsBlock returns the standard timeouts block for a resource. There is
+// no create timeout, as the EchoStream API creates objects synchronously and
+// reports no readiness to wait for once a create mutation has returned.
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{Delete: true, Update: true})
+}
+
+// WaitUntil polls done with exponential backoff until it returns true or an
+// error, or until ctx is done.
+func WaitUntil(ctx context.Context, done func(context.Context) (bool, error)) error {
+	interval := minPollInterval
+	for {
+		if ok, err := done(ctx); err != nil {
+			return err
+		} else if ok {
+			return nil
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timeout exceeded: %w", ctx.Err())
+			}
+			return ctx.Err()
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxPollInterval)
+	}
+}
+
+// WaitUntilDeleted polls exists until it returns false or a NotFound error.
+func WaitUntilDeleted(ctx context.Context, exists func(context.Context) (bool, error)) error {
+	return WaitUntil(ctx, func(ctx context.Context) (bool, error) {
+		found, err := exists(ctx)
+		if IsNotFound(err) {
+			return true, nil
+		}
+		return !found, err
+	})
+}
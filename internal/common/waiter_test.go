@@ -0,0 +1,44 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitUntilDeleted(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	err := WaitUntilDeleted(context.Background(), func(ctx context.Context) (bool, error) {
+		calls++
+		return calls < 2, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	// A NotFound error means the object is gone
+	err = WaitUntilDeleted(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, gqlErrors("NotFound", "Node foo not found")
+	})
+	require.NoError(t, err)
+
+	// Other errors stop the wait
+	err = WaitUntilDeleted(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, errors.New("boom")
+	})
+	require.EqualError(t, err, "boom")
+}
+
+func TestWaitUntilTimeout(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := WaitUntil(ctx, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "timeout exceeded")
+}
@@ -1,6 +1,10 @@
 package edge
 
 import (
+	"context"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -14,3 +18,15 @@ type edgeModel struct {
 	Source          types.String `tfsdk:"source"`
 	Target          types.String `tfsdk:"target"`
 }
+
+// edgeExists returns a function that reports whether the Edge between source and target exists, for use with the
+// common waiters.
+func edgeExists(data *common.ProviderData, source string, target string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadEdge(ctx, data.Client, source, target, data.Tenant)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetEdge != nil, nil
+	}
+}
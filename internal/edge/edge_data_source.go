@@ -52,7 +52,7 @@ func (d *EdgeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	if echoResp, err := api.ReadEdge(ctx, d.data.Client, config.Source.ValueString(), config.Target.ValueString(), d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading Edge", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading Edge", err))
 		return
 	} else if echoResp.GetEdge == nil {
 		resp.State.RemoveResource(ctx)
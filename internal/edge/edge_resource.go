@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,6 +32,11 @@ type EdgeResource struct {
 	data *common.ProviderData
 }
 
+type edgeResourceModel struct {
+	edgeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *EdgeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -52,7 +57,7 @@ func (r *EdgeResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *EdgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan edgeModel
+	var plan edgeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -89,7 +94,7 @@ func (r *EdgeResource) Create(ctx context.Context, req resource.CreateRequest, r
 		kmsKey,
 		maxReceiveCount,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating Edge", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating Edge", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		plan.Arn = types.StringValue(echoResp.CreateEdge.Arn)
@@ -119,7 +124,7 @@ func (r *EdgeResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *EdgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state edgeModel
+	var state edgeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -128,12 +133,24 @@ func (r *EdgeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteEdge(ctx, r.data.Client, state.Source.ValueString(), state.Target.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting Edge", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting Edge", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, edgeExists(r.data, state.Source.ValueString(), state.Target.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for Edge to be deleted", err.Error())
+	}
 }
 
 func (r *EdgeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -155,8 +172,8 @@ func (r *EdgeResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *EdgeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var (
-		plan  edgeModel
-		state edgeModel
+		plan  edgeResourceModel
+		state edgeResourceModel
 	)
 
 	// If the entire state is null or the entire plan is null, resource is being created or destroyed.
@@ -177,7 +194,7 @@ func (r *EdgeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 
 	if !state.Source.Equal(plan.Source) {
 		if echoResp, err := api.ReadNodeMessageTypes(ctx, r.data.Client, plan.Source.ValueString(), r.data.Tenant); err != nil {
-			resp.Diagnostics.AddError("Error reading planned source", err.Error())
+			resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading planned source", err))
 			return
 		} else if echoResp.GetNode == nil {
 			resp.Diagnostics.AddAttributeError(path.Root("source"), "Cannot find Node", fmt.Sprintf("'%s' Node does not exist", plan.Source.ValueString()))
@@ -196,7 +213,7 @@ func (r *EdgeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 	}
 	if !state.Target.Equal(plan.Target) {
 		if echoResp, err := api.ReadNodeMessageTypes(ctx, r.data.Client, plan.Target.ValueString(), r.data.Tenant); err != nil {
-			resp.Diagnostics.AddError("Error reading planned target", err.Error())
+			resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading planned target", err))
 			return
 		} else if echoResp.GetNode == nil {
 			resp.Diagnostics.AddAttributeError(path.Root("target"), "Cannot find Node", fmt.Sprintf("'%s' Node does not exist", plan.Target.ValueString()))
@@ -228,7 +245,7 @@ func (r *EdgeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRe
 }
 
 func (r *EdgeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state edgeModel
+	var state edgeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -238,7 +255,11 @@ func (r *EdgeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	if echoResp, err := api.ReadEdge(ctx, r.data.Client, state.Source.ValueString(), state.Target.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading Edge", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading Edge", err))
 		return
 	} else if echoResp.GetEdge == nil {
 		resp.State.RemoveResource(ctx)
@@ -308,6 +329,9 @@ func (r *EdgeResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[Edges](https://docs.echo.stream/docs/edges) transmit messages of a single MessageType between Nodes.",
 	}
 }
@@ -315,8 +339,8 @@ func (r *EdgeResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 func (r *EdgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var (
 		description *string
-		plan        edgeModel
-		state       edgeModel
+		plan        edgeResourceModel
+		state       edgeResourceModel
 	)
 
 	// Read Terraform plan and state data into the model
@@ -327,6 +351,16 @@ func (r *EdgeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	if !(plan.Description.IsNull() || plan.Description.IsUnknown()) {
 		temp := plan.Description.ValueString()
 		description = &temp
@@ -342,7 +376,7 @@ func (r *EdgeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 			plan.Source.ValueString(),
 			plan.Target.ValueString(),
 		); err != nil {
-			resp.Diagnostics.AddError("Error moving Edge", err.Error())
+			resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error moving Edge", err, common.AttributeNames(req.Plan.Raw)...))
 			return
 		} else if echoResp.GetEdge == nil {
 			resp.Diagnostics.AddError("Cannot move Edge", fmt.Sprintf("'%s:%s' Edge does not exist", plan.Source.ValueString(), plan.Target.ValueString()))
@@ -377,7 +411,7 @@ func (r *EdgeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		r.data.Tenant,
 		description,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating Edge", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating Edge", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetEdge == nil {
 		resp.Diagnostics.AddError("Cannot update Edge", fmt.Sprintf("'%s:%s' Edge does not exist", plan.Source.ValueString(), plan.Target.ValueString()))
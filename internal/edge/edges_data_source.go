@@ -0,0 +1,186 @@
+package edge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSourceWithConfigure = &EdgesDataSource{}
+
+type EdgesDataSource struct {
+	data *common.ProviderData
+}
+
+type edgesDataSourceModel struct {
+	Edges  types.List   `tfsdk:"edges"`
+	Source types.String `tfsdk:"source"`
+	Target types.String `tfsdk:"target"`
+}
+
+func listedEdgeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"arn":               types.StringType,
+		"description":       types.StringType,
+		"kmskey":            types.StringType,
+		"max_receive_count": types.Int64Type,
+		"message_type":      types.StringType,
+		"queue":             types.StringType,
+		"source":            types.StringType,
+		"target":            types.StringType,
+	}
+}
+
+func listedEdgeAttrValues(edge *api.EdgeFields) map[string]attr.Value {
+	var kmsKey types.String
+	if edge.KmsKey != nil {
+		kmsKey = types.StringValue(edge.KmsKey.Name)
+	} else {
+		kmsKey = types.StringNull()
+	}
+	var maxReceiveCount types.Int64
+	if edge.MaxReceiveCount != nil {
+		maxReceiveCount = types.Int64Value(int64(*edge.MaxReceiveCount))
+	} else {
+		maxReceiveCount = types.Int64Null()
+	}
+	return map[string]attr.Value{
+		"arn":               types.StringValue(edge.Arn),
+		"description":       types.StringPointerValue(edge.Description),
+		"kmskey":            kmsKey,
+		"max_receive_count": maxReceiveCount,
+		"message_type":      types.StringValue(edge.MessageType.Name),
+		"queue":             types.StringValue(edge.Queue),
+		"source":            types.StringValue(edge.Source.GetName()),
+		"target":            types.StringValue(edge.Target.GetName()),
+	}
+}
+
+func (d *EdgesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *EdgesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edges"
+}
+
+func (d *EdgesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config edgesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listed, err := common.ListEdges(ctx, d.data)
+	if err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error listing Edges", err))
+		return
+	}
+	var elems []attr.Value
+	for _, edge := range listed {
+		if !config.Source.IsNull() && edge.Source.GetName() != config.Source.ValueString() {
+			continue
+		}
+		if !config.Target.IsNull() && edge.Target.GetName() != config.Target.ValueString() {
+			continue
+		}
+		elem, diags := types.ObjectValue(listedEdgeAttrTypes(), listedEdgeAttrValues(edge))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elems = append(elems, elem)
+	}
+
+	edges, diags := types.ListValue(types.ObjectType{AttrTypes: listedEdgeAttrTypes()}, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Edges = edges
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *EdgesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"edges": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Edges in the Tenant that match all of the filters, in the order returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"arn": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ARN of the underlying AWS SQS Queue.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A human-readable description.",
+						},
+						"kmskey": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the KmsKey used to encrypt the message at rest and in flight.",
+						},
+						"max_receive_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The maximum number of delivery tries to the `target`. `0` will try forever.",
+						},
+						"message_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The MessageType that will be transmitted.",
+						},
+						"queue": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL of the underlying AWS SQS queue.",
+						},
+						"source": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The source Node that messages are transmitted from.",
+						},
+						"target": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The target Node that messages are transmitted to.",
+						},
+					},
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Only list the Edges from this Node (i.e. - its outgoing Edges).",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Only list the Edges to this Node (i.e. - its incoming Edges).",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Lists the [Edges](https://docs.echo.stream/docs/edges) in the Tenant, or the incoming " +
+			"or outgoing Edges of a Node.",
+	}
+}
@@ -3,10 +3,10 @@ package function
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,6 +27,11 @@ type ApiAuthenticatorFunctionResource struct {
 	data *common.ProviderData
 }
 
+type functionResourceModel struct {
+	functionModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *ApiAuthenticatorFunctionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -47,7 +52,7 @@ func (r *ApiAuthenticatorFunctionResource) Configure(ctx context.Context, req re
 }
 
 func (r *ApiAuthenticatorFunctionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan functionModel
+	var plan functionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -84,7 +89,7 @@ func (r *ApiAuthenticatorFunctionResource) Create(ctx context.Context, req resou
 		requirements,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating ApiAuthenticatorFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ApiAuthenticatorFunction", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -114,7 +119,7 @@ func (r *ApiAuthenticatorFunctionResource) Create(ctx context.Context, req resou
 }
 
 func (r *ApiAuthenticatorFunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state functionModel
+	var state functionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -123,12 +128,24 @@ func (r *ApiAuthenticatorFunctionResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteFunction(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting ApiAuthenticatorFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ApiAuthenticatorFunction", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, functionExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ApiAuthenticatorFunction to be deleted", err.Error())
+	}
 }
 
 func (r *ApiAuthenticatorFunctionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -140,7 +157,7 @@ func (r *ApiAuthenticatorFunctionResource) Metadata(ctx context.Context, req res
 }
 
 func (r *ApiAuthenticatorFunctionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state functionModel
+	var state functionResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -162,7 +179,7 @@ func (r *ApiAuthenticatorFunctionResource) ModifyPlan(ctx context.Context, req r
 	// If the entire plan is null, the resource is planned for destruction.
 	prevent_destroy := req.Plan.Raw.IsNull()
 	if !prevent_destroy {
-		var plan functionModel
+		var plan functionResourceModel
 
 		// Read Terraform plan data into the model
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -180,7 +197,7 @@ func (r *ApiAuthenticatorFunctionResource) ModifyPlan(ctx context.Context, req r
 }
 
 func (r *ApiAuthenticatorFunctionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state functionModel
+	var state functionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -199,7 +216,7 @@ func (r *ApiAuthenticatorFunctionResource) Read(ctx context.Context, req resourc
 		resp.State.RemoveResource(ctx)
 		return
 	} else {
-		state = *data
+		state.functionModel = *data
 	}
 
 	// Save updated data into Terraform state
@@ -208,13 +225,16 @@ func (r *ApiAuthenticatorFunctionResource) Read(ctx context.Context, req resourc
 
 func (r *ApiAuthenticatorFunctionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Attributes:          resourceFunctionAttributes(),
+		Attributes: resourceFunctionAttributes(),
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[ApiAuthenticatorFunctions](https://docs.echo.stream/docs/webhook#api-authenticator-function) are managed Functions used in API-based Nodes (e.g. - WebhookNode).",
 	}
 }
 
 func (r *ApiAuthenticatorFunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan functionModel
+	var plan functionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -223,6 +243,16 @@ func (r *ApiAuthenticatorFunctionResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		code         *string
 		description  *string
@@ -261,7 +291,7 @@ func (r *ApiAuthenticatorFunctionResource) Update(ctx context.Context, req resou
 		requirements,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating ApiAuthenticatorFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ApiAuthenticatorFunction", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
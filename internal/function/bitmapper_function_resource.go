@@ -3,10 +3,10 @@ package function
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +29,11 @@ type BitmapperFunctionResource struct {
 	data *common.ProviderData
 }
 
+type bitmapperFunctionResourceModel struct {
+	bitmapperFunctionModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *BitmapperFunctionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -49,7 +54,7 @@ func (r *BitmapperFunctionResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *BitmapperFunctionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan bitmapperFunctionModel
+	var plan bitmapperFunctionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -87,7 +92,7 @@ func (r *BitmapperFunctionResource) Create(ctx context.Context, req resource.Cre
 		requirements,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating BitmapperFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating BitmapperFunction", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -118,7 +123,7 @@ func (r *BitmapperFunctionResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *BitmapperFunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state bitmapperFunctionModel
+	var state bitmapperFunctionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -127,12 +132,24 @@ func (r *BitmapperFunctionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteFunction(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting BitmapperFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting BitmapperFunction", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, functionExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for BitmapperFunction to be deleted", err.Error())
+	}
 }
 
 func (r *BitmapperFunctionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -144,7 +161,7 @@ func (r *BitmapperFunctionResource) Metadata(ctx context.Context, req resource.M
 }
 
 func (r *BitmapperFunctionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state bitmapperFunctionModel
+	var state bitmapperFunctionResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -166,7 +183,7 @@ func (r *BitmapperFunctionResource) ModifyPlan(ctx context.Context, req resource
 	// If the entire plan is null, the resource is planned for destruction.
 	prevent_destroy := req.Plan.Raw.IsNull()
 	if !prevent_destroy {
-		var plan bitmapperFunctionModel
+		var plan bitmapperFunctionResourceModel
 
 		// Read Terraform plan data into the model
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -184,7 +201,7 @@ func (r *BitmapperFunctionResource) ModifyPlan(ctx context.Context, req resource
 }
 
 func (r *BitmapperFunctionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state bitmapperFunctionModel
+	var state bitmapperFunctionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -203,7 +220,7 @@ func (r *BitmapperFunctionResource) Read(ctx context.Context, req resource.ReadR
 		resp.State.RemoveResource(ctx)
 		return
 	} else {
-		state = *data
+		state.bitmapperFunctionModel = *data
 	}
 
 	// Save updated data into Terraform state
@@ -218,13 +235,16 @@ func (r *BitmapperFunctionResource) Schema(ctx context.Context, req resource.Sch
 		Required:            true,
 	}
 	resp.Schema = schema.Schema{
-		Attributes:          attributes,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[BitmapperFunctions](https://docs.echo.stream/docs/bitmap-router-node#bitmapper-function) provide reusable message bitmapping and are used in RouterNodes.",
 	}
 }
 
 func (r *BitmapperFunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan bitmapperFunctionModel
+	var plan bitmapperFunctionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -233,6 +253,16 @@ func (r *BitmapperFunctionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		code         *string
 		description  *string
@@ -271,7 +301,7 @@ func (r *BitmapperFunctionResource) Update(ctx context.Context, req resource.Upd
 		requirements,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating BitmapperFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating BitmapperFunction", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -154,8 +154,8 @@ func readApiAuthenicatorFunction(ctx context.Context, client graphql.Client, nam
 				diags.AddError("Invalid Function type", fmt.Sprintf("'%s' is incorrect Function type", data.Name.String()))
 			}
 		}
-	} else {
-		diags.AddError("Error reading ApiAuthenticatorFunction", err.Error())
+	} else if !common.IsNotFound(err) {
+		diags.Append(common.ApiErrorDiagnostic("Error reading ApiAuthenticatorFunction", err))
 	}
 
 	return data, system, diags
@@ -205,8 +205,8 @@ func readBitmapperFunction(ctx context.Context, client graphql.Client, name stri
 				diags.AddError("Invalid Function type", fmt.Sprintf("'%s' is incorrect Function type", data.Name.String()))
 			}
 		}
-	} else {
-		diags.AddError("Error reading ApiAuthenticatorFunction", err.Error())
+	} else if !common.IsNotFound(err) {
+		diags.Append(common.ApiErrorDiagnostic("Error reading BitmapperFunction", err))
 	}
 
 	return data, system, diags
@@ -261,9 +261,21 @@ func readProcessorFunction(ctx context.Context, client graphql.Client, name stri
 				diags.AddError("Invalid Function type", fmt.Sprintf("'%s' is incorrect Function type", data.Name.String()))
 			}
 		}
-	} else {
-		diags.AddError("Error reading ApiAuthenticatorFunction", err.Error())
+	} else if !common.IsNotFound(err) {
+		diags.Append(common.ApiErrorDiagnostic("Error reading ProcessorFunction", err))
 	}
 
 	return data, system, diags
 }
+
+// functionExists returns a function that reports whether the named Function exists, for use with the
+// common waiters.
+func functionExists(data *common.ProviderData, name string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadFunction(ctx, data.Client, name, data.Tenant)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetFunction != nil, nil
+	}
+}
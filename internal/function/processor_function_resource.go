@@ -3,10 +3,10 @@ package function
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +29,11 @@ type ProcessorFunctionResource struct {
 	data *common.ProviderData
 }
 
+type processorFunctionResourceModel struct {
+	processorFunctionModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *ProcessorFunctionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -49,7 +54,7 @@ func (r *ProcessorFunctionResource) Configure(ctx context.Context, req resource.
 }
 
 func (r *ProcessorFunctionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan processorFunctionModel
+	var plan processorFunctionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -93,7 +98,7 @@ func (r *ProcessorFunctionResource) Create(ctx context.Context, req resource.Cre
 		return_message_type,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating ProcessorFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ProcessorFunction", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -129,7 +134,7 @@ func (r *ProcessorFunctionResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *ProcessorFunctionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state processorFunctionModel
+	var state processorFunctionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -138,12 +143,24 @@ func (r *ProcessorFunctionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteFunction(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting ProcessorFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ProcessorFunction", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, functionExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ProcessorFunction to be deleted", err.Error())
+	}
 }
 
 func (r *ProcessorFunctionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -155,7 +172,7 @@ func (r *ProcessorFunctionResource) Metadata(ctx context.Context, req resource.M
 }
 
 func (r *ProcessorFunctionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state processorFunctionModel
+	var state processorFunctionResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -177,7 +194,7 @@ func (r *ProcessorFunctionResource) ModifyPlan(ctx context.Context, req resource
 	// If the entire plan is null, the resource is planned for destruction.
 	prevent_destroy := req.Plan.Raw.IsNull()
 	if !prevent_destroy {
-		var plan processorFunctionModel
+		var plan processorFunctionResourceModel
 
 		// Read Terraform plan data into the model
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -195,7 +212,7 @@ func (r *ProcessorFunctionResource) ModifyPlan(ctx context.Context, req resource
 }
 
 func (r *ProcessorFunctionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state processorFunctionModel
+	var state processorFunctionResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -214,7 +231,7 @@ func (r *ProcessorFunctionResource) Read(ctx context.Context, req resource.ReadR
 		resp.State.RemoveResource(ctx)
 		return
 	} else {
-		state = *data
+		state.processorFunctionModel = *data
 	}
 
 	// Save updated data into Terraform state
@@ -235,13 +252,16 @@ func (r *ProcessorFunctionResource) Schema(ctx context.Context, req resource.Sch
 	}
 	resp.Schema = schema.Schema{
 		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[ProcessorFunctions](https://docs.echo.stream/docs/processor-node#processor-function) provide " +
 			"reusable message processing and are used in either a ProcessorNode or a CrossTenantSendingNode.",
 	}
 }
 
 func (r *ProcessorFunctionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan processorFunctionModel
+	var plan processorFunctionResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -250,6 +270,16 @@ func (r *ProcessorFunctionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		code         *string
 		description  *string
@@ -288,7 +318,7 @@ func (r *ProcessorFunctionResource) Update(ctx context.Context, req resource.Upd
 		requirements,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating ProcessorFunction", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ProcessorFunction", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
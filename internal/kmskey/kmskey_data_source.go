@@ -0,0 +1,105 @@
+package kmskey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSourceWithConfigure = &KmsKeyDataSource{}
+
+type KmsKeyDataSource struct {
+	data *common.ProviderData
+}
+
+type kmsKeyDataSourceModel struct {
+	Arn         types.String `tfsdk:"arn"`
+	Description types.String `tfsdk:"description"`
+	Id          types.String `tfsdk:"id"`
+	InUse       types.Bool   `tfsdk:"in_use"`
+	Name        types.String `tfsdk:"name"`
+}
+
+func (d *KmsKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *KmsKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_key"
+}
+
+func (d *KmsKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config kmsKeyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if echoResp, err := api.ReadKmsKey(ctx, d.data.Client, config.Name.ValueString(), d.data.Tenant); err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading KmsKey", err))
+		return
+	} else if echoResp.GetKmsKey == nil {
+		resp.Diagnostics.AddError("KmsKey not found", fmt.Sprintf("Unable to find KmsKey '%s'", config.Name.ValueString()))
+		return
+	} else {
+		config.Arn = types.StringValue(echoResp.GetKmsKey.Arn)
+		config.Description = types.StringPointerValue(echoResp.GetKmsKey.Description)
+		config.Id = types.StringValue(echoResp.GetKmsKey.Name)
+		config.InUse = types.BoolValue(echoResp.GetKmsKey.InUse)
+		config.Name = types.StringValue(echoResp.GetKmsKey.Name)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *KmsKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The AWS ARN for the underlying KMS Key.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A human-readable description.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"in_use": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "True if this KmsKey is in use by Edges.",
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the KmsKey.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "A specific KmsKey in the Tenant. KmsKeys are used to encrypt message on Edges.",
+	}
+}
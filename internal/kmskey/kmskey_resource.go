@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,11 +29,12 @@ type KmsKeyResource struct {
 }
 
 type kmsKeyModel struct {
-	Arn         types.String `tfsdk:"arn"`
-	Description types.String `tfsdk:"description"`
-	Id          types.String `tfsdk:"id"`
-	InUse       types.Bool   `tfsdk:"in_use"`
-	Name        types.String `tfsdk:"name"`
+	Arn         types.String   `tfsdk:"arn"`
+	Description types.String   `tfsdk:"description"`
+	Id          types.String   `tfsdk:"id"`
+	InUse       types.Bool     `tfsdk:"in_use"`
+	Name        types.String   `tfsdk:"name"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *KmsKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -80,7 +81,7 @@ func (r *KmsKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		description,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating KmsKey", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating KmsKey", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -108,12 +109,24 @@ func (r *KmsKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteKmsKey(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting KmsKey", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting KmsKey", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, kmsKeyExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for KmsKey to be deleted", err.Error())
+	}
 }
 
 func (r *KmsKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -175,7 +188,11 @@ func (r *KmsKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	if echoResp, err := api.ReadKmsKey(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading KmsKey", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading KmsKey", err))
 		return
 	} else if echoResp.GetKmsKey == nil {
 		resp.State.RemoveResource(ctx)
@@ -227,6 +244,9 @@ func (r *KmsKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "KmsKeys are used to encrypt message on Edges. This enables limiting certain Apps and Nodes, " +
 			"especially External and Managed Nodes that are outside of your control (e.g. - shared with a partner), to specific encryption permissions.",
 	}
@@ -242,6 +262,16 @@ func (r *KmsKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var description *string
 	if !(plan.Description.IsNull() || plan.Description.IsUnknown()) {
 		temp := plan.Description.ValueString()
@@ -256,7 +286,7 @@ func (r *KmsKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		description,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating KmsKey", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating KmsKey", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -273,3 +303,14 @@ func (r *KmsKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
+
+// kmsKeyExists returns a function that reports whether the named KmsKey exists, for use with the common waiters.
+func kmsKeyExists(data *common.ProviderData, name string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadKmsKey(ctx, data.Client, name, data.Tenant)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetKmsKey != nil, nil
+	}
+}
@@ -155,9 +155,21 @@ func readManagedNodeType(ctx context.Context, client graphql.Client, name string
 				system = *echoResp.GetManagedNodeType.System
 			}
 		}
-	} else {
-		diags.AddError("Error reading ManagedNodeType", err.Error())
+	} else if !common.IsNotFound(err) {
+		diags.Append(common.ApiErrorDiagnostic("Error reading ManagedNodeType", err))
 	}
 
 	return data, system, diags
 }
+
+// managedNodeTypeExists returns a function that reports whether the named ManagedNodeType exists, for use with the
+// common waiters.
+func managedNodeTypeExists(data *common.ProviderData, name string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadManagedNodeType(ctx, data.Client, name, data.Tenant)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetManagedNodeType != nil, nil
+	}
+}
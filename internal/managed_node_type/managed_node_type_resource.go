@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -34,6 +34,11 @@ type ManagedNodeTypeResource struct {
 	data *common.ProviderData
 }
 
+type managedNodeTypeResourceModel struct {
+	managedNodeTypeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *ManagedNodeTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -54,7 +59,7 @@ func (r *ManagedNodeTypeResource) Configure(ctx context.Context, req resource.Co
 }
 
 func (r *ManagedNodeTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan managedNodeTypeModel
+	var plan managedNodeTypeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -142,7 +147,7 @@ func (r *ManagedNodeTypeResource) Create(ctx context.Context, req resource.Creat
 		sendMessageType,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating ManagedNodeType", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ManagedNodeType", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -209,7 +214,7 @@ func (r *ManagedNodeTypeResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *ManagedNodeTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state managedNodeTypeModel
+	var state managedNodeTypeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -218,12 +223,24 @@ func (r *ManagedNodeTypeResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteManagedNodeType(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting ManagedNodeType", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ManagedNodeType", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, managedNodeTypeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ManagedNodeType to be deleted", err.Error())
+	}
 }
 
 func (r *ManagedNodeTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -235,7 +252,7 @@ func (r *ManagedNodeTypeResource) Metadata(ctx context.Context, req resource.Met
 }
 
 func (r *ManagedNodeTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state managedNodeTypeModel
+	var state managedNodeTypeResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -257,7 +274,7 @@ func (r *ManagedNodeTypeResource) ModifyPlan(ctx context.Context, req resource.M
 	// If the entire plan is null, the resource is planned for destruction.
 	prevent_destroy := req.Plan.Raw.IsNull()
 	if !prevent_destroy {
-		var plan managedNodeTypeModel
+		var plan managedNodeTypeResourceModel
 
 		// Read Terraform plan data into the model
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -275,7 +292,7 @@ func (r *ManagedNodeTypeResource) ModifyPlan(ctx context.Context, req resource.M
 }
 
 func (r *ManagedNodeTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state managedNodeTypeModel
+	var state managedNodeTypeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -294,7 +311,7 @@ func (r *ManagedNodeTypeResource) Read(ctx context.Context, req resource.ReadReq
 		resp.State.RemoveResource(ctx)
 		return
 	} else {
-		state = *data
+		state.managedNodeTypeModel = *data
 	}
 
 	// Save updated data into Terraform state
@@ -308,8 +325,17 @@ func (r *ManagedNodeTypeResource) Schema(ctx context.Context, req resource.Schem
 				CustomType: common.ConfigType{},
 				MarkdownDescription: "A [JSON Schema](https://json-schema.org/) document that specifies the" +
 					" requirements for the config attribute of ManagedNodes created using this ManagedNodeType.",
-				Optional:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					common.ConfigDiffPlanModifier,
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !common.ConfigsEqual(req.StateValue.ValueString(), req.PlanValue.ValueString())
+						},
+						"Changing the config_template, other than its key order or whitespace, requires replacement.",
+						"Changing the `config_template`, other than its key order or whitespace, requires replacement.",
+					),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "A human-readable description.",
@@ -398,13 +424,16 @@ func (r *ManagedNodeTypeResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "ManagedNodeTypes are wrappers around Docker image definitions and define the requirements " +
 			"necessary to instantiate those images as Docker containers inside a ManagedNode.",
 	}
 }
 
 func (r *ManagedNodeTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan managedNodeTypeModel
+	var plan managedNodeTypeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -413,6 +442,16 @@ func (r *ManagedNodeTypeResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		description *string
 		diags       diag.Diagnostics
@@ -442,7 +481,7 @@ func (r *ManagedNodeTypeResource) Update(ctx context.Context, req resource.Updat
 		readme,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating ManagedNodeType", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ManagedNodeType", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"regexp"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -80,9 +81,21 @@ func readMessageType(ctx context.Context, client graphql.Client, name string, te
 				system = *echoResp.GetMessageType.System
 			}
 		}
-	} else {
-		diags.AddError("Error reading MessageType", err.Error())
+	} else if !common.IsNotFound(err) {
+		diags.Append(common.ApiErrorDiagnostic("Error reading MessageType", err))
 	}
 
 	return data, system, diags
 }
+
+// messageTypeExists returns a function that reports whether the named MessageType exists, for use with the
+// common waiters.
+func messageTypeExists(data *common.ProviderData, name string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadMessageType(ctx, data.Client, name, data.Tenant)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetMessageType != nil, nil
+	}
+}
@@ -3,10 +3,10 @@ package message_type
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,6 +30,11 @@ type MessageTypeResource struct {
 	data *common.ProviderData
 }
 
+type messageTypeResourceModel struct {
+	messageTypeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *MessageTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -50,7 +55,7 @@ func (r *MessageTypeResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *MessageTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan messageTypeModel
+	var plan messageTypeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -90,7 +95,7 @@ func (r *MessageTypeResource) Create(ctx context.Context, req resource.CreateReq
 		requirements,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Message Type", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating Message Type", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -125,7 +130,7 @@ func (r *MessageTypeResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *MessageTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state messageTypeModel
+	var state messageTypeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -134,12 +139,24 @@ func (r *MessageTypeResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteMessageType(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting Message Type", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting Message Type", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, messageTypeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for MessageType to be deleted", err.Error())
+	}
 }
 
 func (r *MessageTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -151,7 +168,7 @@ func (r *MessageTypeResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *MessageTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state messageTypeModel
+	var state messageTypeResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -173,7 +190,7 @@ func (r *MessageTypeResource) ModifyPlan(ctx context.Context, req resource.Modif
 	// If the entire plan is null, the resource is planned for destruction.
 	prevent_destroy := req.Plan.Raw.IsNull()
 	if !prevent_destroy {
-		var plan messageTypeModel
+		var plan messageTypeResourceModel
 
 		// Read Terraform plan data into the model
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -191,7 +208,7 @@ func (r *MessageTypeResource) ModifyPlan(ctx context.Context, req resource.Modif
 }
 
 func (r *MessageTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state messageTypeModel
+	var state messageTypeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -210,7 +227,7 @@ func (r *MessageTypeResource) Read(ctx context.Context, req resource.ReadRequest
 		resp.State.RemoveResource(ctx)
 		return
 	} else {
-		state = *data
+		state.messageTypeModel = *data
 	}
 
 	// Save updated data into Terraform state
@@ -272,13 +289,16 @@ func (r *MessageTypeResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "A specific [MessageType](https://docs.echo.stream/docs/message-types) in the Tenant. " +
 			"All messages sent or received must be loosely associated (via Node and Edge typing) with a MessageType.",
 	}
 }
 
 func (r *MessageTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan messageTypeModel
+	var plan messageTypeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -287,6 +307,16 @@ func (r *MessageTypeResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		auditor           *string
 		bitmapperTemplate *string
@@ -343,7 +373,7 @@ func (r *MessageTypeResource) Update(ctx context.Context, req resource.UpdateReq
 		sampleMessage,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Message Type", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating Message Type", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -58,7 +58,7 @@ func (d *AlertEmitterNodeDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	if echoResp, err := api.ReadNode(ctx, d.data.Client, "Alert Emitter", d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading AlertEmitterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading AlertEmitterNode", err))
 		return
 	} else if echoResp.GetNode != nil {
 		switch node := (*echoResp.GetNode).(type) {
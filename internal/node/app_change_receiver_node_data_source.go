@@ -61,7 +61,7 @@ func (d *AppChangeReceiverNodeDataSource) Read(ctx context.Context, req datasour
 	name := config.App.ValueString() + ":Change Receiver"
 
 	if echoResp, err := api.ReadNode(ctx, d.data.Client, name, d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading AppChangeReceiverNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading AppChangeReceiverNode", err))
 		return
 	} else if echoResp.GetNode != nil {
 		switch node := (*echoResp.GetNode).(type) {
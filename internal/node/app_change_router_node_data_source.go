@@ -59,7 +59,7 @@ func (d *AppChangeRouterNodeDataSource) Read(ctx context.Context, req datasource
 	}
 
 	if echoResp, err := api.ReadNode(ctx, d.data.Client, "App Change Router", d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading AppChangeRouterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading AppChangeRouterNode", err))
 		return
 	} else if echoResp.GetNode != nil {
 		switch node := (*echoResp.GetNode).(type) {
@@ -58,7 +58,7 @@ func (d *AuditEmitterNodeDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	if echoResp, err := api.ReadNode(ctx, d.data.Client, "Audit Emitter", d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading AuditEmitterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading AuditEmitterNode", err))
 		return
 	} else if echoResp.GetNode != nil {
 		switch node := (*echoResp.GetNode).(type) {
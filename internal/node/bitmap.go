@@ -0,0 +1,66 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// routeBitmapRegex matches the route bitmaps used as the keys of a BitmapRouterNode's route_table.
+var routeBitmapRegex = regexp.MustCompile(`^0[xX][a-fA-F0-9]+$`)
+
+// flagBits returns the bit position of each flag in orderedFlags.
+func flagBits(orderedFlags []string) (map[string]int, error) {
+	bits := make(map[string]int, len(orderedFlags))
+	for i, flag := range orderedFlags {
+		if _, ok := bits[flag]; ok {
+			return nil, fmt.Errorf("flag %q appears more than once", flag)
+		}
+		bits[flag] = i
+	}
+	return bits, nil
+}
+
+// encodeBitmap returns the route bitmap with the bits for flags set.
+// The first flag in orderedFlags is the least significant bit.
+func encodeBitmap(flags []string, orderedFlags []string) (string, error) {
+	bits, err := flagBits(orderedFlags)
+	if err != nil {
+		return "", err
+	}
+	bitmap := new(big.Int)
+	for _, flag := range flags {
+		bit, ok := bits[flag]
+		if !ok {
+			return "", fmt.Errorf("flag %q is not one of the ordered flags", flag)
+		}
+		bitmap.SetBit(bitmap, bit, 1)
+	}
+	return "0x" + strings.ToUpper(bitmap.Text(16)), nil
+}
+
+// decodeBitmap returns the flags whose bits are set in the route bitmap, in
+// the order of orderedFlags.
+func decodeBitmap(bitmap string, orderedFlags []string) ([]string, error) {
+	if !routeBitmapRegex.MatchString(bitmap) {
+		return nil, fmt.Errorf("%q must begin with '0x' or '0X' and contain 'a-f', 'A-F' or '0-9'", bitmap)
+	}
+	if _, err := flagBits(orderedFlags); err != nil {
+		return nil, err
+	}
+	b, ok := new(big.Int).SetString(bitmap[2:], 16)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a hexadecimal number", bitmap)
+	}
+	if b.BitLen() > len(orderedFlags) {
+		return nil, fmt.Errorf("%s sets bit %d, but there are only %d ordered flags", bitmap, b.BitLen()-1, len(orderedFlags))
+	}
+	flags := []string{}
+	for i, flag := range orderedFlags {
+		if b.Bit(i) == 1 {
+			flags = append(flags, flag)
+		}
+	}
+	return flags, nil
+}
@@ -0,0 +1,59 @@
+package node
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &BitmapFunction{}
+
+type BitmapFunction struct{}
+
+func (f *BitmapFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Returns the route bitmap (e.g. - `0x5`) with the bits for `flags` set, for use as a key in a " +
+			"BitmapRouterNode's `route_table`. The first flag in `ordered_flags` is the least significant bit.",
+		Parameters: []function.Parameter{
+			function.SetParameter{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The flags to set. Each must be in `ordered_flags`.",
+				Name:                "flags",
+			},
+			function.ListParameter{
+				ElementType:         types.StringType,
+				MarkdownDescription: "All of the flags, ordered from the least significant bit. Must not contain duplicates.",
+				Name:                "ordered_flags",
+			},
+		},
+		Return:  function.StringReturn{},
+		Summary: "Compute a BitmapRouterNode route bitmap from named flags",
+	}
+}
+
+func (f *BitmapFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bitmap"
+}
+
+func (f *BitmapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var flags, orderedFlags []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &flags, &orderedFlags))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := flagBits(orderedFlags); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	bitmap, err := encodeBitmap(flags, orderedFlags)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, bitmap))
+}
@@ -0,0 +1,96 @@
+package node
+
+import (
+	"encoding/json"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+type bitmapRouterNodeModel struct {
+	Config             common.Config `tfsdk:"config"`
+	Description        types.String  `tfsdk:"description"`
+	InlineBitmapper    types.String  `tfsdk:"inline_bitmapper"`
+	LoggingLevel       types.String  `tfsdk:"logging_level"`
+	ManagedBitmapper   types.String  `tfsdk:"managed_bitmapper"`
+	Name               types.String  `tfsdk:"name"`
+	ReceiveMessageType types.String  `tfsdk:"receive_message_type"`
+	Requirements       types.Set     `tfsdk:"requirements"`
+	RouteTable         types.Map     `tfsdk:"route_table"`
+	SendMessageType    types.String  `tfsdk:"send_message_type"`
+}
+
+func (m *bitmapRouterNodeModel) readNode(node *api.ReadNodeGetNodeBitmapRouterNode) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Config = configValue(node.Config)
+	m.Description = types.StringPointerValue(node.Description)
+	m.InlineBitmapper = types.StringPointerValue(node.InlineBitmapper)
+	m.LoggingLevel = loggingLevelValue(node.LoggingLevel)
+	if node.ManagedBitmapper != nil {
+		m.ManagedBitmapper = types.StringValue(node.ManagedBitmapper.Name)
+	} else {
+		m.ManagedBitmapper = types.StringNull()
+	}
+	m.Name = types.StringValue(node.Name)
+	m.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
+	requirements, d := requirementsValue(node.Requirements)
+	diags.Append(d...)
+	m.Requirements = requirements
+	rt := map[string][]string{}
+	if err := json.Unmarshal([]byte(node.RouteTable), &rt); err != nil {
+		diags.AddError("Error unmashalling route_table", err.Error())
+		return diags
+	} else if len(rt) > 0 {
+		elems := map[string]attr.Value{}
+		for route_bitmap, t := range rt {
+			targets := []attr.Value{}
+			for _, target := range t {
+				targets = append(targets, types.StringValue(target))
+			}
+			elems[route_bitmap], d = types.SetValue(types.StringType, targets)
+			diags.Append(d...)
+		}
+		m.RouteTable, d = types.MapValue(types.SetType{ElemType: types.StringType}, elems)
+		diags.Append(d...)
+	} else {
+		m.RouteTable = types.MapNull(types.SetType{ElemType: types.StringType})
+	}
+	m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+
+	return diags
+}
+
+func bitmapRouterNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := sendReceiveNodeDataSourceAttributes()
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"config": configDataSourceAttribute(),
+			"inline_bitmapper": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "A Python code string that contains a single top-level function definition." +
+					" This function must have the signature `(*, context, message, source, **kwargs)`" +
+					" and return an integer.",
+			},
+			"logging_level": loggingLevelDataSourceAttribute(),
+			"managed_bitmapper": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A managed BitmapperFunction.",
+			},
+			"requirements": requirementsDataSourceAttribute(),
+			"route_table": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.SetType{ElemType: types.StringType},
+				MarkdownDescription: "The route table. A route table is a JSON object with hexidecimal (base-16) keys " +
+					"(the route bitmaps - e.g. 0xF1) and a list of target Node names as the values.",
+			},
+		},
+	)
+	return attributes
+}
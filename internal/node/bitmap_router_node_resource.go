@@ -4,11 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"regexp"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -36,17 +35,9 @@ type BitmapRouterNodeResource struct {
 	data *common.ProviderData
 }
 
-type bitmapRouterNodeModel struct {
-	Config             common.Config `tfsdk:"config"`
-	Description        types.String  `tfsdk:"description"`
-	InlineBitmapper    types.String  `tfsdk:"inline_bitmapper"`
-	LoggingLevel       types.String  `tfsdk:"logging_level"`
-	ManagedBitmapper   types.String  `tfsdk:"managed_bitmapper"`
-	Name               types.String  `tfsdk:"name"`
-	ReceiveMessageType types.String  `tfsdk:"receive_message_type"`
-	Requirements       types.Set     `tfsdk:"requirements"`
-	RouteTable         types.Map     `tfsdk:"route_table"`
-	SendMessageType    types.String  `tfsdk:"send_message_type"`
+type bitmapRouterNodeResourceModel struct {
+	bitmapRouterNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *BitmapRouterNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -78,7 +69,7 @@ func (r *BitmapRouterNodeResource) ConfigValidators(ctx context.Context) []resou
 }
 
 func (r *BitmapRouterNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan bitmapRouterNodeModel
+	var plan bitmapRouterNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -153,7 +144,7 @@ func (r *BitmapRouterNodeResource) Create(ctx context.Context, req resource.Crea
 		requirements,
 		routeTable,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating BitmapRouterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating BitmapRouterNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.CreateBitmapRouterNode.Config != nil {
@@ -226,7 +217,7 @@ func (r *BitmapRouterNodeResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *BitmapRouterNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state bitmapRouterNodeModel
+	var state bitmapRouterNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -235,12 +226,24 @@ func (r *BitmapRouterNodeResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting BitmapRouterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting BitmapRouterNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for BitmapRouterNode to be deleted", err.Error())
+	}
 }
 
 func (r *BitmapRouterNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -252,10 +255,7 @@ func (r *BitmapRouterNodeResource) Metadata(ctx context.Context, req resource.Me
 }
 
 func (r *BitmapRouterNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var (
-		diags diag.Diagnostics
-		state bitmapRouterNodeModel
-	)
+	var state bitmapRouterNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -265,7 +265,11 @@ func (r *BitmapRouterNodeResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading BitmapRouterNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading BitmapRouterNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -273,69 +277,7 @@ func (r *BitmapRouterNodeResource) Read(ctx context.Context, req resource.ReadRe
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeBitmapRouterNode:
-			if node.Config != nil {
-				state.Config = common.ConfigValue(*node.Config)
-			} else {
-				state.Config = common.ConfigNull()
-			}
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			if node.InlineBitmapper != nil {
-				state.InlineBitmapper = types.StringValue(*node.InlineBitmapper)
-			} else {
-				state.InlineBitmapper = types.StringNull()
-			}
-			if node.LoggingLevel != nil {
-				state.LoggingLevel = types.StringValue(string(*node.LoggingLevel))
-			} else {
-				state.LoggingLevel = types.StringNull()
-			}
-			if node.ManagedBitmapper != nil {
-				state.ManagedBitmapper = types.StringValue(node.ManagedBitmapper.Name)
-			} else {
-				state.ManagedBitmapper = types.StringNull()
-			}
-			state.Name = types.StringValue(node.Name)
-			state.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
-			if len(node.Requirements) > 0 {
-				elems := []attr.Value{}
-				for _, req := range node.Requirements {
-					elems = append(elems, types.StringValue(req))
-				}
-				state.Requirements, diags = types.SetValue(types.StringType, elems)
-				if diags != nil && diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-				}
-			} else {
-				state.Requirements = types.SetNull(types.StringType)
-			}
-			rt := map[string][]string{}
-			if err := json.Unmarshal([]byte(node.RouteTable), &rt); err != nil {
-				resp.Diagnostics.AddError("Error unmashalling route_table", err.Error())
-				return
-			} else if len(rt) > 0 {
-				elems := map[string]attr.Value{}
-				for route_bitmap, t := range rt {
-					targets := []attr.Value{}
-					for _, target := range t {
-						targets = append(targets, types.StringValue(target))
-					}
-					elems[route_bitmap], diags = types.SetValue(types.StringType, targets)
-					if diags != nil && diags.HasError() {
-						resp.Diagnostics.Append(diags...)
-					}
-				}
-				state.RouteTable, diags = types.MapValue(types.SetType{ElemType: types.StringType}, elems)
-				if diags != nil && diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-				}
-			} else {
-				state.RouteTable = types.MapNull(types.SetType{ElemType: types.StringType})
-			}
-			state.SendMessageType = types.StringValue(node.SendMessageType.Name)
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected BitmapRouterNode",
@@ -356,6 +298,7 @@ func (r *BitmapRouterNodeResource) Schema(ctx context.Context, req resource.Sche
 				CustomType:          common.ConfigType{},
 				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{common.ConfigDiffPlanModifier},
 				Sensitive:           true,
 			},
 			"description": schema.StringAttribute{
@@ -402,7 +345,7 @@ func (r *BitmapRouterNodeResource) Schema(ctx context.Context, req resource.Sche
 				Validators: []validator.Map{
 					mapvalidator.KeysAre(
 						stringvalidator.RegexMatches(
-							regexp.MustCompile(`^0[x|X][a-fA-F0-9]+$`),
+							routeBitmapRegex,
 							"Must begin with '0x' or '0X' and contain 'a-f', 'A-F' or '0-9'",
 						),
 					),
@@ -418,6 +361,9 @@ func (r *BitmapRouterNodeResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "The MessageType that this Node is capable of sending.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[BitmapRouterNodes](https://docs.echo.stream/docs/bitmap-router-node) use a bitmapper function (either " +
 			"inline or managed) to construct a bitmap of truthy values for each message processed. The message bitmap is then _and_'ed with " +
 			"route bitmaps. If the result of the _and_ is equal to the route bitmap then the message is sent along that route.",
@@ -427,7 +373,7 @@ func (r *BitmapRouterNodeResource) Schema(ctx context.Context, req resource.Sche
 func (r *BitmapRouterNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var (
 		diags diag.Diagnostics
-		plan  bitmapRouterNodeModel
+		plan  bitmapRouterNodeResourceModel
 	)
 
 	// Read Terraform plan data into the model
@@ -437,6 +383,16 @@ func (r *BitmapRouterNodeResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config           *string
 		description      *string
@@ -500,7 +456,7 @@ func (r *BitmapRouterNodeResource) Update(ctx context.Context, req resource.Upda
 		requirements,
 		routeTable,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating BitmapRouterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating BitmapRouterNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find BitmapRouterNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
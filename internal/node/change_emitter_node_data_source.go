@@ -58,7 +58,7 @@ func (d *ChangeEmitterNodeDataSource) Read(ctx context.Context, req datasource.R
 	}
 
 	if echoResp, err := api.ReadNode(ctx, d.data.Client, "Change Emitter", d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ChangeEmitterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ChangeEmitterNode", err))
 		return
 	} else if echoResp.GetNode != nil {
 		switch node := (*echoResp.GetNode).(type) {
@@ -0,0 +1,78 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &CronNextFunction{}
+
+type CronNextFunction struct{}
+
+func (f *CronNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Returns the next `count` times, in RFC3339 format and UTC, after `from` that a TimerNode's " +
+			"`schedule_expression` fires. Rate expressions are previewed as if they started at `from`. Fewer than `count` " +
+			"times are returned if the schedule stops firing.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "An Amazon EventBridge cron or rate expression.",
+				Name:                "schedule_expression",
+			},
+			function.StringParameter{
+				MarkdownDescription: "The time, in RFC3339 format, to preview from (e.g. - `plantimestamp()`).",
+				Name:                "from",
+			},
+			function.Int64Parameter{
+				MarkdownDescription: "The number of times to return. Must be between 1 and 100.",
+				Name:                "count",
+			},
+		},
+		Return:  function.ListReturn{ElementType: types.StringType},
+		Summary: "Preview the times that a TimerNode schedule expression fires",
+	}
+}
+
+func (f *CronNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next"
+}
+
+func (f *CronNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		count              int64
+		from               string
+		scheduleExpression string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &scheduleExpression, &from, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := common.ParseSchedule(scheduleExpression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	fromTime, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	if count < 1 || count > 100 {
+		resp.Error = function.NewArgumentFuncError(2, "count must be between 1 and 100")
+		return
+	}
+
+	times := []string{}
+	for _, t := range schedule.Next(fromTime, int(count)) {
+		times = append(times, t.Format(time.RFC3339))
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, times))
+}
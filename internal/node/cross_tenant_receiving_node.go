@@ -0,0 +1,42 @@
+package node
+
+import (
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+type crossTenantReceivingNodeModel struct {
+	App             types.String `tfsdk:"app"`
+	Description     types.String `tfsdk:"description"`
+	Name            types.String `tfsdk:"name"`
+	SendMessageType types.String `tfsdk:"send_message_type"`
+}
+
+func (m *crossTenantReceivingNodeModel) readNode(node *api.ReadNodeGetNodeCrossTenantReceivingNode) diag.Diagnostics {
+	m.App = types.StringValue(node.App.Name)
+	m.Description = types.StringPointerValue(node.Description)
+	m.Name = types.StringValue(node.Name)
+	m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+	return nil
+}
+
+func crossTenantReceivingNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := sendNodeDataSourceAttributes()
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The CrossTenantReceivingApp that this Node is associated with.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the Node. Automatically generated in the format `<sending_tenant>:<sending_node>`.",
+			},
+		},
+	)
+	return attributes
+}
@@ -0,0 +1,83 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSourceWithConfigure = &CrossTenantReceivingNodeDataSource{}
+
+type CrossTenantReceivingNodeDataSource struct {
+	data *common.ProviderData
+}
+
+func (d *CrossTenantReceivingNodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *CrossTenantReceivingNodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cross_tenant_receiving_node"
+}
+
+func (d *CrossTenantReceivingNodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config crossTenantReceivingNodeModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if echoResp, err := api.ReadNode(ctx, d.data.Client, config.Name.ValueString(), d.data.Tenant); err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading CrossTenantReceivingNode", err))
+		return
+	} else if echoResp.GetNode == nil {
+		resp.Diagnostics.AddError("CrossTenantReceivingNode not found", fmt.Sprintf("'%s' does not exist", config.Name.ValueString()))
+		return
+	} else {
+		switch node := (*echoResp.GetNode).(type) {
+		case *api.ReadNodeGetNodeCrossTenantReceivingNode:
+			resp.Diagnostics.Append(config.readNode(node)...)
+		default:
+			resp.Diagnostics.AddError(
+				"Expected CrossTenantReceivingNode",
+				fmt.Sprintf("Received '%s' for '%s'", *(*echoResp.GetNode).GetTypename(), config.Name.ValueString()),
+			)
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *CrossTenantReceivingNodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := crossTenantReceivingNodeDataSourceAttributes()
+	attributes["name"] = nameDataSourceAttribute()
+	resp.Schema = schema.Schema{
+		Attributes:          attributes,
+		MarkdownDescription: "[CrossTenantReceivingNodes](https://docs.echo.stream/docs/cross-tenant-receiving-node) receive messages from other Tenants.",
+	}
+}
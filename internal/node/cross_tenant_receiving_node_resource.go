@@ -3,14 +3,13 @@ package node
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -24,11 +23,9 @@ type CrossTenantReceivingNodeResource struct {
 	data *common.ProviderData
 }
 
-type crossTenantReceivingNodeModel struct {
-	App             types.String `tfsdk:"app"`
-	Description     types.String `tfsdk:"description"`
-	Name            types.String `tfsdk:"name"`
-	SendMessageType types.String `tfsdk:"send_message_type"`
+type crossTenantReceivingNodeResourceModel struct {
+	crossTenantReceivingNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *CrossTenantReceivingNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,7 +55,7 @@ func (r *CrossTenantReceivingNodeResource) Create(ctx context.Context, req resou
 }
 
 func (r *CrossTenantReceivingNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state crossTenantReceivingNodeModel
+	var state crossTenantReceivingNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -67,12 +64,24 @@ func (r *CrossTenantReceivingNodeResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting CrossTenantReceivingNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting CrossTenantReceivingNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for CrossTenantReceivingNode to be deleted", err.Error())
+	}
 }
 
 func (r *CrossTenantReceivingNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -84,7 +93,7 @@ func (r *CrossTenantReceivingNodeResource) Metadata(ctx context.Context, req res
 }
 
 func (r *CrossTenantReceivingNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state crossTenantReceivingNodeModel
+	var state crossTenantReceivingNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -94,7 +103,11 @@ func (r *CrossTenantReceivingNodeResource) Read(ctx context.Context, req resourc
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading CrossTenantReceivingNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading CrossTenantReceivingNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -102,14 +115,7 @@ func (r *CrossTenantReceivingNodeResource) Read(ctx context.Context, req resourc
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeCrossTenantReceivingNode:
-			state.App = types.StringValue(node.App.Name)
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			state.Name = types.StringValue(node.Name)
-			state.SendMessageType = types.StringValue(node.SendMessageType.Name)
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected CrossTenantReceivingNode",
@@ -143,6 +149,9 @@ func (r *CrossTenantReceivingNodeResource) Schema(ctx context.Context, req resou
 				MarkdownDescription: "The MessageType that this Node is capable of sending.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[CrossTenantReceivingNodes](https://docs.echo.stream/docs/cross-tenant-receiving-node) " +
 			"receive messages from other Tenants. Created automatically when the other Tenant's CrossTenantSendingApp has " +
 			"a CrossTenantSendingNode created in it. This means that you cannot create or update this resource; you may only import " +
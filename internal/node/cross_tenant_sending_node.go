@@ -0,0 +1,68 @@
+package node
+
+import (
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+type crossTenantSendingNodeModel struct {
+	App                  types.String  `tfsdk:"app"`
+	Config               common.Config `tfsdk:"config"`
+	Description          types.String  `tfsdk:"description"`
+	InlineProcessor      types.String  `tfsdk:"inline_processor"`
+	LoggingLevel         types.String  `tfsdk:"logging_level"`
+	ManagedProcessor     types.String  `tfsdk:"managed_processor"`
+	Name                 types.String  `tfsdk:"name"`
+	ReceiveMessageType   types.String  `tfsdk:"receive_message_type"`
+	Requirements         types.Set     `tfsdk:"requirements"`
+	SendMessageType      types.String  `tfsdk:"send_message_type"`
+	SequentialProcessing types.Bool    `tfsdk:"sequential_processing"`
+}
+
+func (m *crossTenantSendingNodeModel) readNode(node *api.ReadNodeGetNodeCrossTenantSendingNode) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.App = types.StringValue(node.App.Name)
+	m.Config = configValue(node.Config)
+	m.Description = types.StringPointerValue(node.Description)
+	m.InlineProcessor = types.StringPointerValue(node.InlineProcessor)
+	m.LoggingLevel = loggingLevelValue(node.LoggingLevel)
+	if node.ManagedProcessor != nil {
+		m.ManagedProcessor = types.StringValue(node.ManagedProcessor.Name)
+	} else {
+		m.ManagedProcessor = types.StringNull()
+	}
+	m.Name = types.StringValue(node.Name)
+	m.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
+	m.Requirements, diags = requirementsValue(node.Requirements)
+	if node.SendMessageType != nil {
+		m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+	} else {
+		m.SendMessageType = types.StringNull()
+	}
+	if node.SequentialProcessing != nil {
+		m.SequentialProcessing = types.BoolValue(*node.SequentialProcessing)
+	} else {
+		m.SequentialProcessing = types.BoolValue(false)
+	}
+
+	return diags
+}
+
+func crossTenantSendingNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := processorNodeDataSourceAttributes()
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The CrossTenantSendingApp this Node is associated with.",
+			},
+		},
+	)
+	return attributes
+}
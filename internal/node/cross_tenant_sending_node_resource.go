@@ -3,10 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -32,18 +32,9 @@ type CrossTenantSendingNodeResource struct {
 	data *common.ProviderData
 }
 
-type crossTenantSendingNodeModel struct {
-	App                  types.String  `tfsdk:"app"`
-	Config               common.Config `tfsdk:"config"`
-	Description          types.String  `tfsdk:"description"`
-	InlineProcessor      types.String  `tfsdk:"inline_processor"`
-	LoggingLevel         types.String  `tfsdk:"logging_level"`
-	ManagedProcessor     types.String  `tfsdk:"managed_processor"`
-	Name                 types.String  `tfsdk:"name"`
-	ReceiveMessageType   types.String  `tfsdk:"receive_message_type"`
-	Requirements         types.Set     `tfsdk:"requirements"`
-	SendMessageType      types.String  `tfsdk:"send_message_type"`
-	SequentialProcessing types.Bool    `tfsdk:"sequential_processing"`
+type crossTenantSendingNodeResourceModel struct {
+	crossTenantSendingNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *CrossTenantSendingNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -75,7 +66,7 @@ func (r *CrossTenantSendingNodeResource) ConfigValidators(ctx context.Context) [
 }
 
 func (r *CrossTenantSendingNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan crossTenantSendingNodeModel
+	var plan crossTenantSendingNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -148,7 +139,7 @@ func (r *CrossTenantSendingNodeResource) Create(ctx context.Context, req resourc
 		sendMessageType,
 		sequentialProcessing,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating CrossTenantSendingNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating CrossTenantSendingNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		plan.App = types.StringValue(echoResp.CreateCrossTenantSendingNode.App.Name)
@@ -208,7 +199,7 @@ func (r *CrossTenantSendingNodeResource) Create(ctx context.Context, req resourc
 }
 
 func (r *CrossTenantSendingNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state crossTenantSendingNodeModel
+	var state crossTenantSendingNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -217,12 +208,24 @@ func (r *CrossTenantSendingNodeResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting CrossTenantSendingNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting CrossTenantSendingNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for CrossTenantSendingNode to be deleted", err.Error())
+	}
 }
 
 func (r *CrossTenantSendingNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -234,7 +237,7 @@ func (r *CrossTenantSendingNodeResource) Metadata(ctx context.Context, req resou
 }
 
 func (r *CrossTenantSendingNodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var state crossTenantSendingNodeModel
+	var state crossTenantSendingNodeResourceModel
 
 	// If the entire state is null, resource is being created.
 	if req.State.Raw.IsNull() {
@@ -252,7 +255,7 @@ func (r *CrossTenantSendingNodeResource) ModifyPlan(ctx context.Context, req res
 	// Read Terraform state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	var plan crossTenantSendingNodeModel
+	var plan crossTenantSendingNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -288,10 +291,7 @@ func (r *CrossTenantSendingNodeResource) ModifyPlan(ctx context.Context, req res
 }
 
 func (r *CrossTenantSendingNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var (
-		diags diag.Diagnostics
-		state crossTenantSendingNodeModel
-	)
+	var state crossTenantSendingNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -301,7 +301,11 @@ func (r *CrossTenantSendingNodeResource) Read(ctx context.Context, req resource.
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading CrossTenantSendingNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading CrossTenantSendingNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -309,56 +313,7 @@ func (r *CrossTenantSendingNodeResource) Read(ctx context.Context, req resource.
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeCrossTenantSendingNode:
-			state.App = types.StringValue(node.App.Name)
-			if node.Config != nil {
-				state.Config = common.ConfigValue(*node.Config)
-			} else {
-				state.Config = common.ConfigNull()
-			}
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			if node.InlineProcessor != nil {
-				state.InlineProcessor = types.StringValue(*node.InlineProcessor)
-			} else {
-				state.InlineProcessor = types.StringNull()
-			}
-			if node.LoggingLevel != nil {
-				state.LoggingLevel = types.StringValue(string(*node.LoggingLevel))
-			} else {
-				state.LoggingLevel = types.StringNull()
-			}
-			if node.ManagedProcessor != nil {
-				state.ManagedProcessor = types.StringValue(node.ManagedProcessor.Name)
-			} else {
-				state.ManagedProcessor = types.StringNull()
-			}
-			state.Name = types.StringValue(node.Name)
-			state.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
-			if len(node.Requirements) > 0 {
-				elems := []attr.Value{}
-				for _, req := range node.Requirements {
-					elems = append(elems, types.StringValue(req))
-				}
-				state.Requirements, diags = types.SetValue(types.StringType, elems)
-				if diags != nil && diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-				}
-			} else {
-				state.Requirements = types.SetNull(types.StringType)
-			}
-			if node.SendMessageType != nil {
-				state.SendMessageType = types.StringValue(node.SendMessageType.Name)
-			} else {
-				state.SendMessageType = types.StringNull()
-			}
-			if node.SequentialProcessing != nil {
-				state.SequentialProcessing = types.BoolValue(*node.SequentialProcessing)
-			} else {
-				state.SequentialProcessing = types.BoolValue(false)
-			}
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected CrossTenantSendingNode",
@@ -384,6 +339,7 @@ func (r *CrossTenantSendingNodeResource) Schema(ctx context.Context, req resourc
 				CustomType:          common.ConfigType{},
 				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{common.ConfigDiffPlanModifier},
 				Sensitive:           true,
 			},
 			"description": schema.StringAttribute{
@@ -434,12 +390,15 @@ func (r *CrossTenantSendingNodeResource) Schema(ctx context.Context, req resourc
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[CrossTenantSendingNodes](https://docs.echo.stream/docs/cross-tenant-sending-node) send messages to a receiving Tenant.",
 	}
 }
 
 func (r *CrossTenantSendingNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan crossTenantSendingNodeModel
+	var plan crossTenantSendingNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -448,6 +407,16 @@ func (r *CrossTenantSendingNodeResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config               *string
 		description          *string
@@ -503,7 +472,7 @@ func (r *CrossTenantSendingNodeResource) Update(ctx context.Context, req resourc
 		requirements,
 		sequentialProcessing,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating CrossTenantSendingNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating CrossTenantSendingNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find CrossTenantSendingNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
@@ -58,7 +58,7 @@ func (d *DeadLetterEmitterNodeDataSource) Read(ctx context.Context, req datasour
 	}
 
 	if echoResp, err := api.ReadNode(ctx, d.data.Client, "Dead Letter Emitter", d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading DeadLetterEmitterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading DeadLetterEmitterNode", err))
 		return
 	} else if echoResp.GetNode != nil {
 		switch node := (*echoResp.GetNode).(type) {
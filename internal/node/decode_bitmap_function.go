@@ -0,0 +1,61 @@
+package node
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &DecodeBitmapFunction{}
+
+type DecodeBitmapFunction struct{}
+
+func (f *DecodeBitmapFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		MarkdownDescription: "Returns the flags whose bits are set in a route bitmap, in the order of `ordered_flags`. " +
+			"The inverse of `bitmap`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				MarkdownDescription: "The route bitmap. Must begin with `0x` or `0X` and contain `a-f`, `A-F` or `0-9`.",
+				Name:                "bitmap",
+			},
+			function.ListParameter{
+				ElementType:         types.StringType,
+				MarkdownDescription: "All of the flags, ordered from the least significant bit. Must not contain duplicates.",
+				Name:                "ordered_flags",
+			},
+		},
+		Return:  function.ListReturn{ElementType: types.StringType},
+		Summary: "Decode a BitmapRouterNode route bitmap into named flags",
+	}
+}
+
+func (f *DecodeBitmapFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decode_bitmap"
+}
+
+func (f *DecodeBitmapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		bitmap       string
+		orderedFlags []string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &bitmap, &orderedFlags))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := flagBits(orderedFlags); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	flags, err := decodeBitmap(bitmap, orderedFlags)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, flags))
+}
@@ -1,8 +1,14 @@
 package node
 
 import (
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
 )
 
 type externalNodeModel struct {
@@ -13,3 +19,49 @@ type externalNodeModel struct {
 	ReceiveMessageType types.String  `tfsdk:"receive_message_type"`
 	SendMessageType    types.String  `tfsdk:"send_message_type"`
 }
+
+func (m *externalNodeModel) readNode(node *api.ReadNodeGetNodeExternalNode) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch app := (node.App).(type) {
+	case *api.ExternalNodeFieldsAppCrossAccountApp:
+		m.App = types.StringValue(app.Name)
+	case *api.ExternalNodeFieldsAppExternalApp:
+		m.App = types.StringValue(app.Name)
+	default:
+		diags.AddError(
+			"Invalid App type",
+			fmt.Sprintf("Expected CrossAccountApp or ExternalApp, got %s", *app.GetTypename()),
+		)
+	}
+	m.Config = configValue(node.Config)
+	m.Description = types.StringPointerValue(node.Description)
+	m.Name = types.StringValue(node.Name)
+	if node.ReceiveMessageType != nil {
+		m.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
+	} else {
+		m.ReceiveMessageType = types.StringNull()
+	}
+	if node.SendMessageType != nil {
+		m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+	} else {
+		m.SendMessageType = types.StringNull()
+	}
+
+	return diags
+}
+
+func externalNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := sendReceiveNodeDataSourceAttributes()
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ExternalApp or CrossAccountApp this Node is associated with.",
+			},
+			"config": configDataSourceAttribute(),
+		},
+	)
+	return attributes
+}
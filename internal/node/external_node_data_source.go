@@ -8,7 +8,6 @@ import (
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -52,7 +51,7 @@ func (d *ExternalNodeDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	if echoResp, err := api.ReadNode(ctx, d.data.Client, config.Name.ValueString(), d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ExternalNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ExternalNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -60,38 +59,7 @@ func (d *ExternalNodeDataSource) Read(ctx context.Context, req datasource.ReadRe
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeExternalNode:
-			switch app := (node.App).(type) {
-			case *api.ExternalNodeFieldsAppCrossAccountApp:
-				config.App = types.StringValue(app.Name)
-			case *api.ExternalNodeFieldsAppExternalApp:
-				config.App = types.StringValue(app.Name)
-			default:
-				resp.Diagnostics.AddError(
-					"Invalid App type",
-					fmt.Sprintf("Expected CrossAccountApp or ExternalApp, got %s", *app.GetTypename()),
-				)
-			}
-			if node.Config != nil {
-				config.Config = common.ConfigValue(*node.Config)
-			} else {
-				config.Config = common.ConfigNull()
-			}
-			if node.Description != nil {
-				config.Description = types.StringValue(*node.Description)
-			} else {
-				config.Description = types.StringNull()
-			}
-			config.Name = types.StringValue(node.Name)
-			if node.ReceiveMessageType != nil {
-				config.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
-			} else {
-				config.ReceiveMessageType = types.StringNull()
-			}
-			if node.SendMessageType != nil {
-				config.SendMessageType = types.StringValue(node.SendMessageType.Name)
-			} else {
-				config.SendMessageType = types.StringNull()
-			}
+			resp.Diagnostics.Append(config.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected ExternalNode",
@@ -106,35 +74,10 @@ func (d *ExternalNodeDataSource) Read(ctx context.Context, req datasource.ReadRe
 }
 
 func (d *ExternalNodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := externalNodeDataSourceAttributes()
+	attributes["name"] = nameDataSourceAttribute()
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"app": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "The ExternalApp or CrossAccountApp this Node is associated with.",
-			},
-			"config": schema.StringAttribute{
-				Computed:            true,
-				CustomType:          common.ConfigType{},
-				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
-				Sensitive:           true,
-			},
-			"description": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "A human-readable description.",
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the Node. Must be unique within the Tenant.",
-				Required:            true,
-			},
-			"receive_message_type": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "The MessageType that this Node is capable of receiving.",
-			},
-			"send_message_type": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "The MessageType that this Node is capable of sending.",
-			},
-		},
+		Attributes: attributes,
 		MarkdownDescription: "[ExternalNodes](https://docs.echo.stream/docs/external-node) exist outside the " +
 			"EchoStream Cloud. Can be part of an ExternalApp or CrossAccountApp. You may use any computing resource " +
 			"or language that you want to implement them.",
@@ -3,10 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,6 +26,11 @@ type ExternalNodeResource struct {
 	data *common.ProviderData
 }
 
+type externalNodeResourceModel struct {
+	externalNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *ExternalNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -46,7 +51,7 @@ func (r *ExternalNodeResource) Configure(ctx context.Context, req resource.Confi
 }
 
 func (r *ExternalNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan externalNodeModel
+	var plan externalNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -90,7 +95,7 @@ func (r *ExternalNodeResource) Create(ctx context.Context, req resource.CreateRe
 		receiveMessageType,
 		sendMessageType,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating ExternalNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ExternalNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		switch app := (echoResp.CreateExternalNode.App).(type) {
@@ -132,7 +137,7 @@ func (r *ExternalNodeResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *ExternalNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state externalNodeModel
+	var state externalNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -141,12 +146,24 @@ func (r *ExternalNodeResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting ExternalNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ExternalNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ExternalNode to be deleted", err.Error())
+	}
 }
 
 func (r *ExternalNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -158,7 +175,7 @@ func (r *ExternalNodeResource) Metadata(ctx context.Context, req resource.Metada
 }
 
 func (r *ExternalNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state externalNodeModel
+	var state externalNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -168,7 +185,11 @@ func (r *ExternalNodeResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ExternalNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ExternalNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -176,38 +197,7 @@ func (r *ExternalNodeResource) Read(ctx context.Context, req resource.ReadReques
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeExternalNode:
-			switch app := (node.App).(type) {
-			case *api.ExternalNodeFieldsAppCrossAccountApp:
-				state.App = types.StringValue(app.Name)
-			case *api.ExternalNodeFieldsAppExternalApp:
-				state.App = types.StringValue(app.Name)
-			default:
-				resp.Diagnostics.AddError(
-					"Invalid App type",
-					fmt.Sprintf("Expected CrossAccountApp or ExternalApp, got %s", *app.GetTypename()),
-				)
-			}
-			if node.Config != nil {
-				state.Config = common.ConfigValue(*node.Config)
-			} else {
-				state.Config = common.ConfigNull()
-			}
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			state.Name = types.StringValue(node.Name)
-			if node.ReceiveMessageType != nil {
-				state.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
-			} else {
-				state.ReceiveMessageType = types.StringNull()
-			}
-			if node.SendMessageType != nil {
-				state.SendMessageType = types.StringValue(node.SendMessageType.Name)
-			} else {
-				state.SendMessageType = types.StringNull()
-			}
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected ExternalNode",
@@ -233,6 +223,7 @@ func (r *ExternalNodeResource) Schema(ctx context.Context, req resource.SchemaRe
 				CustomType:          common.ConfigType{},
 				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{common.ConfigDiffPlanModifier},
 				Sensitive:           true,
 			},
 			"description": schema.StringAttribute{
@@ -256,6 +247,9 @@ func (r *ExternalNodeResource) Schema(ctx context.Context, req resource.SchemaRe
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[ExternalNodes](https://docs.echo.stream/docs/external-node) exist outside the " +
 			"EchoStream Cloud. Can be part of an ExternalApp or CrossAccountApp. You may use any computing resource " +
 			"or language that you want to implement them.",
@@ -263,7 +257,7 @@ func (r *ExternalNodeResource) Schema(ctx context.Context, req resource.SchemaRe
 }
 
 func (r *ExternalNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan externalNodeModel
+	var plan externalNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -272,6 +266,16 @@ func (r *ExternalNodeResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config      *string
 		description *string
@@ -293,7 +297,7 @@ func (r *ExternalNodeResource) Update(ctx context.Context, req resource.UpdateRe
 		config,
 		description,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating ExternalNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ExternalNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find ExternalNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
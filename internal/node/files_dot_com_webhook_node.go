@@ -0,0 +1,48 @@
+package node
+
+import (
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+type filesDotComWebhookNodeModel struct {
+	Description     types.String `tfsdk:"description"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Name            types.String `tfsdk:"name"`
+	SendMessageType types.String `tfsdk:"send_message_type"`
+	Token           types.String `tfsdk:"token"`
+}
+
+func (m *filesDotComWebhookNodeModel) readNode(node *api.ReadNodeGetNodeFilesDotComWebhookNode) diag.Diagnostics {
+	m.Description = types.StringPointerValue(node.Description)
+	m.Endpoint = types.StringValue(node.Endpoint)
+	m.Name = types.StringValue(node.Name)
+	m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+	m.Token = types.StringValue(node.Token)
+	return nil
+}
+
+func filesDotComWebhookNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := sendNodeDataSourceAttributes()
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Webhooks endpoint to forward Files.com webhooks events to. Accepts all version of Files.com webhook events at the root path.",
+			},
+			"token": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The token for the event endpoint. Files.com doesn't support real Webhooks" +
+					" security, so we add a token that is to be sent in the webhook in the headers." +
+					" Place this token as the value for the `Authorization` header, prepending it with `Bearer`." +
+					" For example, if token was `12345` then the header would be `Authorization: Bearer 12345`.",
+				Sensitive: true,
+			},
+		},
+	)
+	return attributes
+}
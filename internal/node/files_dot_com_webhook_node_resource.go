@@ -3,10 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,13 +28,10 @@ type FilesDotComWebhookNodeResource struct {
 	data *common.ProviderData
 }
 
-type filesDotComWebhookNodeModel struct {
-	ApiKey          types.String `tfsdk:"api_key"`
-	Description     types.String `tfsdk:"description"`
-	Endpoint        types.String `tfsdk:"endpoint"`
-	Name            types.String `tfsdk:"name"`
-	SendMessageType types.String `tfsdk:"send_message_type"`
-	Token           types.String `tfsdk:"token"`
+type filesDotComWebhookNodeResourceModel struct {
+	filesDotComWebhookNodeModel
+	ApiKey   types.String   `tfsdk:"api_key"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *FilesDotComWebhookNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -57,7 +54,7 @@ func (r *FilesDotComWebhookNodeResource) Configure(ctx context.Context, req reso
 }
 
 func (r *FilesDotComWebhookNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan filesDotComWebhookNodeModel
+	var plan filesDotComWebhookNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -84,7 +81,7 @@ func (r *FilesDotComWebhookNodeResource) Create(ctx context.Context, req resourc
 		r.data.Tenant,
 		description,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating FilesDotComWebhookNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating FilesDotComWebhookNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.CreateFilesDotComWebhookNode.Description != nil {
@@ -103,7 +100,7 @@ func (r *FilesDotComWebhookNodeResource) Create(ctx context.Context, req resourc
 }
 
 func (r *FilesDotComWebhookNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state filesDotComWebhookNodeModel
+	var state filesDotComWebhookNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -112,12 +109,24 @@ func (r *FilesDotComWebhookNodeResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting FilesDotComWebhookNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting FilesDotComWebhookNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for FilesDotComWebhookNode to be deleted", err.Error())
+	}
 }
 
 func (r *FilesDotComWebhookNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -129,7 +138,7 @@ func (r *FilesDotComWebhookNodeResource) Metadata(ctx context.Context, req resou
 }
 
 func (r *FilesDotComWebhookNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state filesDotComWebhookNodeModel
+	var state filesDotComWebhookNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -139,7 +148,11 @@ func (r *FilesDotComWebhookNodeResource) Read(ctx context.Context, req resource.
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading FilesDotComWebhookNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading FilesDotComWebhookNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -147,15 +160,7 @@ func (r *FilesDotComWebhookNodeResource) Read(ctx context.Context, req resource.
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeFilesDotComWebhookNode:
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			state.Endpoint = types.StringValue(node.Endpoint)
-			state.Name = types.StringValue(node.Name)
-			state.SendMessageType = types.StringValue(node.SendMessageType.Name)
-			state.Token = types.StringValue(node.Token)
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected FilesDotComWebhookNode",
@@ -206,11 +211,14 @@ func (r *FilesDotComWebhookNodeResource) Schema(ctx context.Context, req resourc
 				Sensitive: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[FilesDotComWebhookNodes](https://docs.echo.stream/docs/filescom-webhook-node) receive webhooks from [Files.com](https://www.files.com).",
 	}
 }
 func (r *FilesDotComWebhookNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan filesDotComWebhookNodeModel
+	var plan filesDotComWebhookNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -219,6 +227,16 @@ func (r *FilesDotComWebhookNodeResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		apiKey      *string
 		description *string
@@ -240,7 +258,7 @@ func (r *FilesDotComWebhookNodeResource) Update(ctx context.Context, req resourc
 		apiKey,
 		description,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating FilesDotComWebhookNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating FilesDotComWebhookNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find FilesDotComWebhookNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
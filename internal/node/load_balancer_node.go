@@ -0,0 +1,27 @@
+package node
+
+import (
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type loadBalancerNodeModel struct {
+	Description        types.String `tfsdk:"description"`
+	Name               types.String `tfsdk:"name"`
+	ReceiveMessageType types.String `tfsdk:"receive_message_type"`
+	SendMessageType    types.String `tfsdk:"send_message_type"`
+}
+
+func (m *loadBalancerNodeModel) readNode(node *api.ReadNodeGetNodeLoadBalancerNode) diag.Diagnostics {
+	m.Description = types.StringPointerValue(node.Description)
+	m.Name = types.StringValue(node.Name)
+	m.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
+	m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+	return nil
+}
+
+func loadBalancerNodeDataSourceAttributes() map[string]schema.Attribute {
+	return sendReceiveNodeDataSourceAttributes()
+}
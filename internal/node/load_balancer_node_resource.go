@@ -3,10 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,11 +26,9 @@ type LoadBalancerNodeResource struct {
 	data *common.ProviderData
 }
 
-type loadBalancerNodeModel struct {
-	Description        types.String `tfsdk:"description"`
-	Name               types.String `tfsdk:"name"`
-	ReceiveMessageType types.String `tfsdk:"receive_message_type"`
-	SendMessageType    types.String `tfsdk:"send_message_type"`
+type loadBalancerNodeResourceModel struct {
+	loadBalancerNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *LoadBalancerNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -53,7 +51,7 @@ func (r *LoadBalancerNodeResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *LoadBalancerNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan loadBalancerNodeModel
+	var plan loadBalancerNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -76,7 +74,7 @@ func (r *LoadBalancerNodeResource) Create(ctx context.Context, req resource.Crea
 		r.data.Tenant,
 		description,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating LoadBalancerNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating LoadBalancerNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.CreateLoadBalancerNode.Description != nil {
@@ -94,7 +92,7 @@ func (r *LoadBalancerNodeResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *LoadBalancerNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state loadBalancerNodeModel
+	var state loadBalancerNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -103,12 +101,24 @@ func (r *LoadBalancerNodeResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting LoadBalancerNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting LoadBalancerNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for LoadBalancerNode to be deleted", err.Error())
+	}
 }
 
 func (r *LoadBalancerNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -120,7 +130,7 @@ func (r *LoadBalancerNodeResource) Metadata(ctx context.Context, req resource.Me
 }
 
 func (r *LoadBalancerNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state loadBalancerNodeModel
+	var state loadBalancerNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -130,7 +140,11 @@ func (r *LoadBalancerNodeResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading LoadBalancerNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading LoadBalancerNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -138,14 +152,7 @@ func (r *LoadBalancerNodeResource) Read(ctx context.Context, req resource.ReadRe
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeLoadBalancerNode:
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			state.Name = types.StringValue(node.Name)
-			state.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
-			state.SendMessageType = types.StringValue(node.SendMessageType.Name)
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected LoadBalancerNode",
@@ -182,6 +189,9 @@ func (r *LoadBalancerNodeResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "The MessageType that this Node is capable of sending.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[LoadBalancerNodes](https://docs.echo.stream/docs/load-balancer) balance receive messages across all send Edges by " +
 			"distributing messages evenly and then randomly distributing any remaining messages. While not required, all Nodes that are targets to a " +
 			"LoadBalancerNode's send Edges should be clones of each other. By definition will eliminate guaranteed ordering.",
@@ -189,7 +199,7 @@ func (r *LoadBalancerNodeResource) Schema(ctx context.Context, req resource.Sche
 }
 
 func (r *LoadBalancerNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan loadBalancerNodeModel
+	var plan loadBalancerNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -198,6 +208,16 @@ func (r *LoadBalancerNodeResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var description *string
 	if !(plan.Description.IsNull() || plan.Description.IsUnknown()) {
 		temp := plan.Description.ValueString()
@@ -211,7 +231,7 @@ func (r *LoadBalancerNodeResource) Update(ctx context.Context, req resource.Upda
 		r.data.Tenant,
 		description,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating LoadBalancerNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating LoadBalancerNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find LoadBalancerNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
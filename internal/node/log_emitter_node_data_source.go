@@ -58,7 +58,7 @@ func (d *LogEmitterNodeDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	if echoResp, err := api.ReadNode(ctx, d.data.Client, "Log Emitter", d.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading LogEmitterNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading LogEmitterNode", err))
 		return
 	} else if echoResp.GetNode != nil {
 		switch node := (*echoResp.GetNode).(type) {
@@ -0,0 +1,142 @@
+package node
+
+import (
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+type managedNodeModel struct {
+	App                types.String  `tfsdk:"app"`
+	Config             common.Config `tfsdk:"config"`
+	Description        types.String  `tfsdk:"description"`
+	LoggingLevel       types.String  `tfsdk:"logging_level"`
+	ManagedNodeType    types.String  `tfsdk:"managed_node_type"`
+	Mounts             types.Set     `tfsdk:"mounts"`
+	Name               types.String  `tfsdk:"name"`
+	Ports              types.Set     `tfsdk:"ports"`
+	ReceiveMessageType types.String  `tfsdk:"receive_message_type"`
+	SendMessageType    types.String  `tfsdk:"send_message_type"`
+}
+
+func (m *managedNodeModel) readNode(node *api.ReadNodeGetNodeManagedNode) diag.Diagnostics {
+	var (
+		d     diag.Diagnostics
+		diags diag.Diagnostics
+	)
+
+	m.App = types.StringValue(node.App.Name)
+	m.Config = configValue(node.Config)
+	m.Description = types.StringPointerValue(node.Description)
+	m.LoggingLevel = loggingLevelValue(node.LoggingLevel)
+	m.ManagedNodeType = types.StringValue(node.ManagedNodeType.Name)
+	if len(node.Mounts) > 0 {
+		elems := []attr.Value{}
+		for _, mount := range node.Mounts {
+			elem, d := types.ObjectValue(mountAttrTypes(), mountAttrValues(mount.Description, mount.Source, mount.Target))
+			diags.Append(d...)
+			elems = append(elems, elem)
+		}
+		m.Mounts, d = types.SetValue(types.ObjectType{AttrTypes: mountAttrTypes()}, elems)
+		diags.Append(d...)
+	} else {
+		m.Mounts = types.SetNull(types.ObjectType{AttrTypes: mountAttrTypes()})
+	}
+	m.Name = types.StringValue(node.Name)
+	if len(node.Ports) > 0 {
+		elems := []attr.Value{}
+		for _, port := range node.Ports {
+			elem, d := types.ObjectValue(portAttrTypes(), portAttrValues(port.ContainerPort, port.Description, port.HostAddress, port.HostPort, port.Protocol))
+			diags.Append(d...)
+			elems = append(elems, elem)
+		}
+		m.Ports, d = types.SetValue(types.ObjectType{AttrTypes: portAttrTypes()}, elems)
+		diags.Append(d...)
+	} else {
+		m.Ports = types.SetNull(types.ObjectType{AttrTypes: portAttrTypes()})
+	}
+	if node.ReceiveMessageType != nil {
+		m.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
+	} else {
+		m.ReceiveMessageType = types.StringNull()
+	}
+	if node.SendMessageType != nil {
+		m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+	} else {
+		m.SendMessageType = types.StringNull()
+	}
+
+	return diags
+}
+
+func managedNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := sendReceiveNodeDataSourceAttributes()
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ManagedApp that this Node is associated with.",
+			},
+			"config":        configDataSourceAttribute(),
+			"logging_level": loggingLevelDataSourceAttribute(),
+			"managed_node_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ManagedNodeType of this ManagedNode.",
+			},
+			"mounts": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "A list of the mounts (i.e. - volumes) used by the Docker container.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A human-readable description.",
+						},
+						"source": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The source of the mount.",
+						},
+						"target": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The path to mount the volume in the Docker container.",
+						},
+					},
+				},
+			},
+			"ports": schema.SetNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "A list of ports exposed by the Docker container.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"container_port": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The exposed container port.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A human-readable description.",
+						},
+						"host_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The host address the port is exposed on.",
+						},
+						"host_port": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The exposed host port.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The protocol to use for the port. One of `sctp`, `tcp` or `udp`.",
+						},
+					},
+				},
+			},
+		},
+	)
+	return attributes
+}
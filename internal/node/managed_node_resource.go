@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -26,6 +26,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &ManagedNodeResource{}
 	_ resource.ResourceWithImportState = &ManagedNodeResource{}
+	_ resource.ResourceWithModifyPlan  = &ManagedNodeResource{}
 )
 
 // ManagedNodeResource defines the resource implementation.
@@ -33,17 +34,9 @@ type ManagedNodeResource struct {
 	data *common.ProviderData
 }
 
-type managedNodeModel struct {
-	App                types.String  `tfsdk:"app"`
-	Config             common.Config `tfsdk:"config"`
-	Description        types.String  `tfsdk:"description"`
-	LoggingLevel       types.String  `tfsdk:"logging_level"`
-	ManagedNodeType    types.String  `tfsdk:"managed_node_type"`
-	Mounts             types.Set     `tfsdk:"mounts"`
-	Name               types.String  `tfsdk:"name"`
-	Ports              types.Set     `tfsdk:"ports"`
-	ReceiveMessageType types.String  `tfsdk:"receive_message_type"`
-	SendMessageType    types.String  `tfsdk:"send_message_type"`
+type managedNodeResourceModel struct {
+	managedNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type mountInputModel struct {
@@ -138,7 +131,7 @@ func (r *ManagedNodeResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *ManagedNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan managedNodeModel
+	var plan managedNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -220,7 +213,7 @@ func (r *ManagedNodeResource) Create(ctx context.Context, req resource.CreateReq
 		mounts,
 		ports,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating ManagedNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ManagedNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		plan.App = types.StringValue(echoResp.CreateManagedNode.App.Name)
@@ -290,7 +283,7 @@ func (r *ManagedNodeResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *ManagedNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state managedNodeModel
+	var state managedNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -299,12 +292,24 @@ func (r *ManagedNodeResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting ManagedNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ManagedNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ManagedNode to be deleted", err.Error())
+	}
 }
 
 func (r *ManagedNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -315,11 +320,97 @@ func (r *ManagedNodeResource) Metadata(ctx context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_managed_node"
 }
 
+func (r *ManagedNodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan managedNodeResourceModel
+
+	// If the entire plan is null, the resource is planned for destruction.
+	// Without provider data, the ManagedNodeType cannot be read.
+	if req.Plan.Raw.IsNull() || r.data == nil {
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Config.IsUnknown() || plan.ManagedNodeType.IsUnknown() {
+		return
+	}
+
+	// The ManagedNodeType may be created or replaced, with a different
+	// config_template, in the same apply as a ManagedNode that starts using
+	// it. Only a ManagedNodeType that is already in use by this ManagedNode
+	// cannot be, so only then are violations errors.
+	inUse := false
+	if !req.State.Raw.IsNull() {
+		var state managedNodeResourceModel
+
+		// Read Terraform state data into the model
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The config already matched the config_template if neither has changed
+		if plan.Config.Equal(state.Config) && plan.ManagedNodeType.Equal(state.ManagedNodeType) {
+			return
+		}
+		inUse = plan.ManagedNodeType.Equal(state.ManagedNodeType)
+	}
+
+	var configTemplate string
+
+	if echoResp, err := api.ReadManagedNodeType(ctx, r.data.Client, plan.ManagedNodeType.ValueString(), r.data.Tenant); err != nil {
+		// The ManagedNodeType may be created in the same apply
+		if !common.IsNotFound(err) {
+			resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ManagedNodeType", err))
+		}
+		return
+	} else if echoResp.GetManagedNodeType == nil || echoResp.GetManagedNodeType.ConfigTemplate == nil {
+		return
+	} else {
+		configTemplate = *echoResp.GetManagedNodeType.ConfigTemplate
+	}
+
+	// A ManagedNode without a config is validated as an empty config
+	config := "{}"
+	if !plan.Config.IsNull() {
+		config = plan.Config.ValueConfig()
+	}
+
+	violations, err := common.ValidateConfigTemplate(config, configTemplate)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("config"),
+			"Cannot validate config against ManagedNodeType config_template",
+			fmt.Sprintf("'%s' ManagedNodeType's config_template cannot be used: %s", plan.ManagedNodeType.ValueString(), err.Error()),
+		)
+		return
+	}
+	for _, violation := range violations {
+		detail := fmt.Sprintf("At #%s: %s", violation.Pointer, violation.Message)
+		if inUse {
+			resp.Diagnostics.AddAttributeError(path.Root("config"), "Config does not match ManagedNodeType config_template", detail)
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("config"),
+			"Config does not match ManagedNodeType config_template",
+			detail+fmt.Sprintf(
+				"\n\nThis is the current config_template of '%s' ManagedNodeType. If the ManagedNodeType is created or replaced in "+
+					"this apply, check the config against its new config_template instead.",
+				plan.ManagedNodeType.ValueString(),
+			),
+		)
+	}
+}
+
 func (r *ManagedNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var (
-		diags diag.Diagnostics
-		state managedNodeModel
-	)
+	var state managedNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -329,7 +420,11 @@ func (r *ManagedNodeResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ManagedNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ManagedNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -337,66 +432,7 @@ func (r *ManagedNodeResource) Read(ctx context.Context, req resource.ReadRequest
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeManagedNode:
-			state.App = types.StringValue(node.App.Name)
-			if node.Config != nil {
-				state.Config = common.ConfigValue(*node.Config)
-			} else {
-				state.Config = common.ConfigNull()
-			}
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			if node.LoggingLevel != nil {
-				state.LoggingLevel = types.StringValue(string(*node.LoggingLevel))
-			} else {
-				state.LoggingLevel = types.StringNull()
-			}
-			state.ManagedNodeType = types.StringValue(node.ManagedNodeType.Name)
-			if len(node.Mounts) > 0 {
-				elems := []attr.Value{}
-				for _, mount := range node.Mounts {
-					if elem, diags := types.ObjectValue(mountAttrTypes(), mountAttrValues(mount.Description, mount.Source, mount.Target)); diags != nil {
-						resp.Diagnostics.Append(diags...)
-					} else {
-						elems = append(elems, elem)
-					}
-				}
-				state.Mounts, diags = types.SetValue(types.ObjectType{AttrTypes: mountAttrTypes()}, elems)
-				if diags != nil && diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-				}
-			} else {
-				state.Mounts = types.SetNull(types.ObjectType{AttrTypes: mountAttrTypes()})
-			}
-			state.Name = types.StringValue(node.Name)
-			if len(node.Ports) > 0 {
-				elems := []attr.Value{}
-				for _, port := range node.Ports {
-					if elem, diags := types.ObjectValue(portAttrTypes(), portAttrValues(port.ContainerPort, port.Description, port.HostAddress, port.HostPort, port.Protocol)); diags != nil {
-						resp.Diagnostics.Append(diags...)
-					} else {
-						elems = append(elems, elem)
-					}
-				}
-				state.Ports, diags = types.SetValue(types.ObjectType{AttrTypes: portAttrTypes()}, elems)
-				if diags != nil && diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-				}
-			} else {
-				state.Ports = types.SetNull(types.ObjectType{AttrTypes: portAttrTypes()})
-			}
-			if node.ReceiveMessageType != nil {
-				state.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
-			} else {
-				state.ReceiveMessageType = types.StringNull()
-			}
-			if node.SendMessageType != nil {
-				state.SendMessageType = types.StringValue(node.SendMessageType.Name)
-			} else {
-				state.SendMessageType = types.StringNull()
-			}
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected ManagedNode",
@@ -419,10 +455,13 @@ func (r *ManagedNodeResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:            true,
 			},
 			"config": schema.StringAttribute{
-				CustomType:          common.ConfigType{},
-				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
-				Optional:            true,
-				Sensitive:           true,
+				CustomType: common.ConfigType{},
+				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map). Validated when planning against the" +
+					" current `config_template` of the `managed_node_type`, if it exists. Violations are warnings until this ManagedNode" +
+					" uses the `managed_node_type`, as its `config_template` may change in the same apply, and errors after.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{common.ConfigDiffPlanModifier},
+				Sensitive:     true,
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "A human-readable description.",
@@ -514,12 +553,15 @@ func (r *ManagedNodeResource) Schema(ctx context.Context, req resource.SchemaReq
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[ManagedNodes](https://docs.echo.stream/docs/managed-node) are instances of Docker containers that exist within ManagedApps.",
 	}
 }
 
 func (r *ManagedNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan managedNodeModel
+	var plan managedNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -528,6 +570,16 @@ func (r *ManagedNodeResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config       *string
 		description  *string
@@ -598,7 +650,7 @@ func (r *ManagedNodeResource) Update(ctx context.Context, req resource.UpdateReq
 		mounts,
 		ports,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating ManagedNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ManagedNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find ManagedNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
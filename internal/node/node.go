@@ -1,7 +1,14 @@
 package node
 
 import (
+	"context"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/exp/maps"
 )
 
@@ -51,3 +58,73 @@ func sendReceiveNodeDataSourceAttributes() map[string]schema.Attribute {
 	maps.Copy(attributes, sendNodeDataSourceAttributes())
 	return attributes
 }
+
+func nameDataSourceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The name of the Node.",
+		Required:            true,
+	}
+}
+
+func configDataSourceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:            true,
+		CustomType:          common.ConfigType{},
+		MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
+		Sensitive:           true,
+	}
+}
+
+func loggingLevelDataSourceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The logging level. One of `DEBUG`, `ERROR`, `INFO`, `WARNING`.",
+	}
+}
+
+func requirementsDataSourceAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The list of Python requirements, in [pip](https://pip.pypa.io/en/stable/reference/requirement-specifiers/) format.",
+	}
+}
+
+func configValue(config *string) common.Config {
+	if config == nil {
+		return common.ConfigNull()
+	}
+	return common.ConfigValue(*config)
+}
+
+func loggingLevelValue(loggingLevel *api.LogLevel) types.String {
+	if loggingLevel == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(*loggingLevel))
+}
+
+// requirementsValue returns requirements as a set, which is null if there
+// are none.
+func requirementsValue(requirements []string) (types.Set, diag.Diagnostics) {
+	if len(requirements) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	elems := []attr.Value{}
+	for _, req := range requirements {
+		elems = append(elems, types.StringValue(req))
+	}
+	return types.SetValue(types.StringType, elems)
+}
+
+// nodeExists returns a function that reports whether the named Node exists, for use with the
+// common waiters.
+func nodeExists(data *common.ProviderData, name string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadNode(ctx, data.Client, name, data.Tenant)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetNode != nil, nil
+	}
+}
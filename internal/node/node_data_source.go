@@ -0,0 +1,205 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSourceWithConfigure = &NodeDataSource{}
+
+type NodeDataSource struct {
+	data *common.ProviderData
+}
+
+type nodeDataSourceModel struct {
+	BitmapRouterNode         types.Object `tfsdk:"bitmap_router_node"`
+	CrossTenantReceivingNode types.Object `tfsdk:"cross_tenant_receiving_node"`
+	CrossTenantSendingNode   types.Object `tfsdk:"cross_tenant_sending_node"`
+	Description              types.String `tfsdk:"description"`
+	ExternalNode             types.Object `tfsdk:"external_node"`
+	FilesDotComWebhookNode   types.Object `tfsdk:"files_dot_com_webhook_node"`
+	LoadBalancerNode         types.Object `tfsdk:"load_balancer_node"`
+	ManagedNode              types.Object `tfsdk:"managed_node"`
+	Name                     types.String `tfsdk:"name"`
+	ProcessorNode            types.Object `tfsdk:"processor_node"`
+	TimerNode                types.Object `tfsdk:"timer_node"`
+	Type                     types.String `tfsdk:"type"`
+	WebhookNode              types.Object `tfsdk:"webhook_node"`
+	WebSubHubNode            types.Object `tfsdk:"web_sub_hub_node"`
+}
+
+// nodeKindAttrTypes returns the object attribute types for a Node kind's data source attributes.
+func nodeKindAttrTypes(attributes map[string]schema.Attribute) map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(attributes))
+	for name, attribute := range attributes {
+		attrTypes[name] = attribute.GetType()
+	}
+	return attrTypes
+}
+
+func nodeKindAttribute(attributes map[string]schema.Attribute, nodeType string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes:          attributes,
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("The %s attributes. Only set if `type` is `%s`.", nodeType, nodeType),
+	}
+}
+
+func nodeKindNull(attributes map[string]schema.Attribute) types.Object {
+	return types.ObjectNull(nodeKindAttrTypes(attributes))
+}
+
+func (d *NodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *NodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node"
+}
+
+func (d *NodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config nodeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	echoResp, err := api.ReadNode(ctx, d.data.Client, config.Name.ValueString(), d.data.Tenant)
+	if err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading Node", err))
+		return
+	} else if echoResp.GetNode == nil {
+		resp.Diagnostics.AddError("Node not found", fmt.Sprintf("'%s' does not exist", config.Name.ValueString()))
+		return
+	}
+
+	node := *echoResp.GetNode
+	config.Description = types.StringPointerValue(node.GetDescription())
+	config.Name = types.StringValue(node.GetName())
+	config.Type = types.StringPointerValue(node.GetTypename())
+
+	config.BitmapRouterNode = nodeKindNull(bitmapRouterNodeDataSourceAttributes())
+	config.CrossTenantReceivingNode = nodeKindNull(crossTenantReceivingNodeDataSourceAttributes())
+	config.CrossTenantSendingNode = nodeKindNull(crossTenantSendingNodeDataSourceAttributes())
+	config.ExternalNode = nodeKindNull(externalNodeDataSourceAttributes())
+	config.FilesDotComWebhookNode = nodeKindNull(filesDotComWebhookNodeDataSourceAttributes())
+	config.LoadBalancerNode = nodeKindNull(loadBalancerNodeDataSourceAttributes())
+	config.ManagedNode = nodeKindNull(managedNodeDataSourceAttributes())
+	config.ProcessorNode = nodeKindNull(processorNodeDataSourceAttributes())
+	config.TimerNode = nodeKindNull(timerNodeDataSourceAttributes())
+	config.WebhookNode = nodeKindNull(webhookNodeDataSourceAttributes())
+	config.WebSubHubNode = nodeKindNull(webSubHubNodeDataSourceAttributes())
+
+	var diags diag.Diagnostics
+	switch node := node.(type) {
+	case *api.ReadNodeGetNodeBitmapRouterNode:
+		var m bitmapRouterNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.BitmapRouterNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(bitmapRouterNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeCrossTenantReceivingNode:
+		var m crossTenantReceivingNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.CrossTenantReceivingNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(crossTenantReceivingNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeCrossTenantSendingNode:
+		var m crossTenantSendingNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.CrossTenantSendingNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(crossTenantSendingNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeExternalNode:
+		var m externalNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.ExternalNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(externalNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeFilesDotComWebhookNode:
+		var m filesDotComWebhookNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.FilesDotComWebhookNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(filesDotComWebhookNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeLoadBalancerNode:
+		var m loadBalancerNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.LoadBalancerNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(loadBalancerNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeManagedNode:
+		var m managedNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.ManagedNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(managedNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeProcessorNode:
+		var m processorNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.ProcessorNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(processorNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeTimerNode:
+		var m timerNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.TimerNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(timerNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeWebhookNode:
+		var m webhookNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.WebhookNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(webhookNodeDataSourceAttributes()), &m)
+	case *api.ReadNodeGetNodeWebSubHubNode:
+		var m webSubHubNodeModel
+		resp.Diagnostics.Append(m.readNode(node)...)
+		config.WebSubHubNode, diags = types.ObjectValueFrom(ctx, nodeKindAttrTypes(webSubHubNodeDataSourceAttributes()), &m)
+	}
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *NodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bitmap_router_node":          nodeKindAttribute(bitmapRouterNodeDataSourceAttributes(), "BitmapRouterNode"),
+			"cross_tenant_receiving_node": nodeKindAttribute(crossTenantReceivingNodeDataSourceAttributes(), "CrossTenantReceivingNode"),
+			"cross_tenant_sending_node":   nodeKindAttribute(crossTenantSendingNodeDataSourceAttributes(), "CrossTenantSendingNode"),
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A human-readable description.",
+			},
+			"external_node":              nodeKindAttribute(externalNodeDataSourceAttributes(), "ExternalNode"),
+			"files_dot_com_webhook_node": nodeKindAttribute(filesDotComWebhookNodeDataSourceAttributes(), "FilesDotComWebhookNode"),
+			"load_balancer_node":         nodeKindAttribute(loadBalancerNodeDataSourceAttributes(), "LoadBalancerNode"),
+			"managed_node":               nodeKindAttribute(managedNodeDataSourceAttributes(), "ManagedNode"),
+			"name":                       nameDataSourceAttribute(),
+			"processor_node":             nodeKindAttribute(processorNodeDataSourceAttributes(), "ProcessorNode"),
+			"timer_node":                 nodeKindAttribute(timerNodeDataSourceAttributes(), "TimerNode"),
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the Node (e.g. - `ProcessorNode`).",
+			},
+			"webhook_node":     nodeKindAttribute(webhookNodeDataSourceAttributes(), "WebhookNode"),
+			"web_sub_hub_node": nodeKindAttribute(webSubHubNodeDataSourceAttributes(), "WebSubHubNode"),
+		},
+		MarkdownDescription: "Reads any Node by name. The common attributes are always set; the attributes specific to the " +
+			"Node's type are set in the nested attribute matching `type` (e.g. `processor_node`). All other nested " +
+			"attributes are null. Emitter, router and subscription Nodes only have the common attributes.",
+	}
+}
@@ -0,0 +1,231 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSourceWithConfigure = &NodesDataSource{}
+
+// nodeTypes are the GraphQL type names of all Nodes.
+var nodeTypes = []string{
+	"AlertEmitterNode",
+	"AppChangeReceiverNode",
+	"AppChangeRouterNode",
+	"AuditEmitterNode",
+	"BitmapRouterNode",
+	"ChangeEmitterNode",
+	"CrossTenantReceivingNode",
+	"CrossTenantSendingNode",
+	"DeadLetterEmitterNode",
+	"ExternalNode",
+	"FilesDotComWebhookNode",
+	"LoadBalancerNode",
+	"LogEmitterNode",
+	"ManagedNode",
+	"ProcessorNode",
+	"TimerNode",
+	"WebhookNode",
+	"WebSubHubNode",
+	"WebSubSubscriptionNode",
+}
+
+type NodesDataSource struct {
+	data *common.ProviderData
+}
+
+type nodesDataSourceModel struct {
+	MessageType types.String `tfsdk:"message_type"`
+	NameRegex   types.String `tfsdk:"name_regex"`
+	Nodes       types.List   `tfsdk:"nodes"`
+	Types       types.Set    `tfsdk:"types"`
+}
+
+func listedNodeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"app":                  types.StringType,
+		"description":          types.StringType,
+		"name":                 types.StringType,
+		"receive_message_type": types.StringType,
+		"send_message_type":    types.StringType,
+		"type":                 types.StringType,
+	}
+}
+
+func listedNodeAttrValues(n *common.ListedNode) map[string]attr.Value {
+	return map[string]attr.Value{
+		"app":                  types.StringPointerValue(n.App),
+		"description":          types.StringPointerValue(n.Description),
+		"name":                 types.StringValue(n.Name),
+		"receive_message_type": types.StringPointerValue(n.ReceiveMessageType),
+		"send_message_type":    types.StringPointerValue(n.SendMessageType),
+		"type":                 types.StringValue(n.Type),
+	}
+}
+
+// listedNodeMatches returns true if the Node passes all of the filters.
+func listedNodeMatches(n *common.ListedNode, messageType string, nameRegex *regexp.Regexp, nodeTypes map[string]bool) bool {
+	if len(nodeTypes) > 0 && !nodeTypes[n.Type] {
+		return false
+	}
+	if messageType != "" &&
+		(n.ReceiveMessageType == nil || *n.ReceiveMessageType != messageType) &&
+		(n.SendMessageType == nil || *n.SendMessageType != messageType) {
+		return false
+	}
+	return nameRegex == nil || nameRegex.MatchString(n.Name)
+}
+
+func (d *NodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *NodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+func (d *NodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config nodesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		// Validation is skipped for values that are unknown until apply
+		var err error
+		if nameRegex, err = regexp.Compile(config.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Expected valid regular expression", err.Error())
+			return
+		}
+	}
+	filterTypes := map[string]bool{}
+	if !config.Types.IsNull() {
+		var nodeTypes []string
+		resp.Diagnostics.Append(config.Types.ElementsAs(ctx, &nodeTypes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, nodeType := range nodeTypes {
+			filterTypes[nodeType] = true
+		}
+	}
+
+	listed, err := common.ListNodes(ctx, d.data)
+	if err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error listing Nodes", err))
+		return
+	}
+	var elems []attr.Value
+	for _, n := range listed {
+		if !listedNodeMatches(n, config.MessageType.ValueString(), nameRegex, filterTypes) {
+			continue
+		}
+		elem, diags := types.ObjectValue(listedNodeAttrTypes(), listedNodeAttrValues(n))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		elems = append(elems, elem)
+	}
+
+	nodes, diags := types.ListValue(types.ObjectType{AttrTypes: listedNodeAttrTypes()}, elems)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Nodes = nodes
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *NodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"message_type": schema.StringAttribute{
+				MarkdownDescription: "Only list Nodes that receive or send this MessageType.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list Nodes whose name matches this regular expression.",
+				Optional:            true,
+				Validators:          []validator.String{validators.Regexp()},
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Nodes in the Tenant that match all of the filters, in the order returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"app": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The App this Node is associated with, if any.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A human-readable description.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the Node.",
+						},
+						"receive_message_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The MessageType that this Node is capable of receiving, if any.",
+						},
+						"send_message_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The MessageType that this Node is capable of sending, if any.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the Node (e.g. - `ProcessorNode`).",
+						},
+					},
+				},
+			},
+			"types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only list Nodes of these types (e.g. - `ProcessorNode`).",
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(nodeTypes...)),
+				},
+			},
+		},
+		MarkdownDescription: "Lists the [Nodes](https://docs.echo.stream/docs/nodes) in the Tenant, optionally " +
+			"filtered by type, MessageType and name.",
+	}
+}
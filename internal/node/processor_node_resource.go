@@ -3,10 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -31,17 +31,9 @@ type ProcessorNodeResource struct {
 	data *common.ProviderData
 }
 
-type processorNodeModel struct {
-	Config               common.Config `tfsdk:"config"`
-	Description          types.String  `tfsdk:"description"`
-	InlineProcessor      types.String  `tfsdk:"inline_processor"`
-	LoggingLevel         types.String  `tfsdk:"logging_level"`
-	ManagedProcessor     types.String  `tfsdk:"managed_processor"`
-	Name                 types.String  `tfsdk:"name"`
-	ReceiveMessageType   types.String  `tfsdk:"receive_message_type"`
-	Requirements         types.Set     `tfsdk:"requirements"`
-	SendMessageType      types.String  `tfsdk:"send_message_type"`
-	SequentialProcessing types.Bool    `tfsdk:"sequential_processing"`
+type processorNodeResourceModel struct {
+	processorNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ProcessorNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -73,7 +65,7 @@ func (r *ProcessorNodeResource) ConfigValidators(ctx context.Context) []resource
 }
 
 func (r *ProcessorNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan processorNodeModel
+	var plan processorNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -145,7 +137,7 @@ func (r *ProcessorNodeResource) Create(ctx context.Context, req resource.CreateR
 		sendMessageType,
 		sequentialProcessing,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating ProcessorNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ProcessorNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.CreateProcessorNode.Config != nil {
@@ -204,7 +196,7 @@ func (r *ProcessorNodeResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *ProcessorNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state processorNodeModel
+	var state processorNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -213,12 +205,24 @@ func (r *ProcessorNodeResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting ProcessorNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ProcessorNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ProcessorNode to be deleted", err.Error())
+	}
 }
 
 func (r *ProcessorNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -230,10 +234,7 @@ func (r *ProcessorNodeResource) Metadata(ctx context.Context, req resource.Metad
 }
 
 func (r *ProcessorNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var (
-		diags diag.Diagnostics
-		state processorNodeModel
-	)
+	var state processorNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -243,7 +244,11 @@ func (r *ProcessorNodeResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading ProcessorNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ProcessorNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -251,55 +256,7 @@ func (r *ProcessorNodeResource) Read(ctx context.Context, req resource.ReadReque
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeProcessorNode:
-			if node.Config != nil {
-				state.Config = common.ConfigValue(*node.Config)
-			} else {
-				state.Config = common.ConfigNull()
-			}
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			if node.InlineProcessor != nil {
-				state.InlineProcessor = types.StringValue(*node.InlineProcessor)
-			} else {
-				state.InlineProcessor = types.StringNull()
-			}
-			if node.LoggingLevel != nil {
-				state.LoggingLevel = types.StringValue(string(*node.LoggingLevel))
-			} else {
-				state.LoggingLevel = types.StringNull()
-			}
-			if node.ManagedProcessor != nil {
-				state.ManagedProcessor = types.StringValue(node.ManagedProcessor.Name)
-			} else {
-				state.ManagedProcessor = types.StringNull()
-			}
-			state.Name = types.StringValue(node.Name)
-			state.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
-			if len(node.Requirements) > 0 {
-				elems := []attr.Value{}
-				for _, req := range node.Requirements {
-					elems = append(elems, types.StringValue(req))
-				}
-				state.Requirements, diags = types.SetValue(types.StringType, elems)
-				if diags != nil && diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-				}
-			} else {
-				state.Requirements = types.SetNull(types.StringType)
-			}
-			if node.SendMessageType != nil {
-				state.SendMessageType = types.StringValue(node.SendMessageType.Name)
-			} else {
-				state.SendMessageType = types.StringNull()
-			}
-			if node.SequentialProcessing != nil {
-				state.SequentialProcessing = types.BoolValue(*node.SequentialProcessing)
-			} else {
-				state.SequentialProcessing = types.BoolValue(false)
-			}
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected ProcessorNode",
@@ -320,6 +277,7 @@ func (r *ProcessorNodeResource) Schema(ctx context.Context, req resource.SchemaR
 				CustomType:          common.ConfigType{},
 				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{common.ConfigDiffPlanModifier},
 				Sensitive:           true,
 			},
 			"description": schema.StringAttribute{
@@ -370,13 +328,16 @@ func (r *ProcessorNodeResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[ProcessorNodes](https://docs.echo.stream/docs/processor-node) allow for almost any processing of messages, " +
 			"including transformation, augmentation, generation, combination and splitting.",
 	}
 }
 
 func (r *ProcessorNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan processorNodeModel
+	var plan processorNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -385,6 +346,16 @@ func (r *ProcessorNodeResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config               *string
 		description          *string
@@ -440,7 +411,7 @@ func (r *ProcessorNodeResource) Update(ctx context.Context, req resource.UpdateR
 		requirements,
 		sequentialProcessing,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating ProcessorNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ProcessorNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find ProcessorNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
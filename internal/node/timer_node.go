@@ -0,0 +1,76 @@
+package node
+
+import (
+	"time"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+type timerNodeModel struct {
+	Description        types.String `tfsdk:"description"`
+	Name               types.String `tfsdk:"name"`
+	NextFireTimes      types.List   `tfsdk:"next_fire_times"`
+	ScheduleExpression types.String `tfsdk:"schedule_expression"`
+	SendMessageType    types.String `tfsdk:"send_message_type"`
+}
+
+func (m *timerNodeModel) readNode(node *api.ReadNodeGetNodeTimerNode) diag.Diagnostics {
+	m.Description = types.StringPointerValue(node.Description)
+	m.Name = types.StringValue(node.Name)
+	m.NextFireTimes = nextFireTimes(node.ScheduleExpression)
+	m.ScheduleExpression = types.StringValue(node.ScheduleExpression)
+	m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+	return nil
+}
+
+const (
+	nextFireTimesDescription = "A preview of the next five times, in RFC3339 format and UTC, that the `schedule_expression` fires, " +
+		"as of when this was last read (e.g. - when it was created or refreshed). It is not recomputed when planning, so times may " +
+		"have passed by the time that it is used. Rate expressions are previewed as if they started when this was last read."
+	scheduleExpressionDescription = "An [Amazon Event Bridge schedule expression]" +
+		"(https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html), either a cron " +
+		"expression (e.g. - `cron(0 12 * * ? *)`, or without the surrounding `cron()`) or a rate expression (e.g. - `rate(5 minutes)`). " +
+		"The `day_of_week` of a cron expression is numbered from 1 (`SUN`) to 7 (`SAT`); 0 is still accepted for Sunday, but is deprecated."
+)
+
+// nextFireTimesCount is the number of times previewed in next_fire_times.
+const nextFireTimesCount = 5
+
+// nextFireTimes returns the next times, in RFC3339 format, that
+// scheduleExpression fires. It is null if scheduleExpression cannot be parsed.
+func nextFireTimes(scheduleExpression string) types.List {
+	schedule, err := common.ParseSchedule(scheduleExpression)
+	if err != nil {
+		return types.ListNull(types.StringType)
+	}
+	times := []attr.Value{}
+	for _, t := range schedule.Next(time.Now(), nextFireTimesCount) {
+		times = append(times, types.StringValue(t.Format(time.RFC3339)))
+	}
+	return types.ListValueMust(types.StringType, times)
+}
+
+func timerNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := sendNodeDataSourceAttributes()
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"next_fire_times": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: nextFireTimesDescription,
+			},
+			"schedule_expression": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: scheduleExpressionDescription,
+			},
+		},
+	)
+	return attributes
+}
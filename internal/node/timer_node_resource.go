@@ -3,15 +3,14 @@ package node
 import (
 	"context"
 	"fmt"
-	"regexp"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -29,11 +28,9 @@ type TimerNodeResource struct {
 	data *common.ProviderData
 }
 
-type timerNodeModel struct {
-	Description        types.String `tfsdk:"description"`
-	Name               types.String `tfsdk:"name"`
-	ScheduleExpression types.String `tfsdk:"schedule_expression"`
-	SendMessageType    types.String `tfsdk:"send_message_type"`
+type timerNodeResourceModel struct {
+	timerNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TimerNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,7 +53,7 @@ func (r *TimerNodeResource) Configure(ctx context.Context, req resource.Configur
 }
 
 func (r *TimerNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan timerNodeModel
+	var plan timerNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -79,7 +76,7 @@ func (r *TimerNodeResource) Create(ctx context.Context, req resource.CreateReque
 		r.data.Tenant,
 		description,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating TimerNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating TimerNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.CreateTimerNode.Description != nil {
@@ -88,6 +85,7 @@ func (r *TimerNodeResource) Create(ctx context.Context, req resource.CreateReque
 			plan.Description = types.StringNull()
 		}
 		plan.Name = types.StringValue(echoResp.CreateTimerNode.Name)
+		plan.NextFireTimes = nextFireTimes(echoResp.CreateTimerNode.ScheduleExpression)
 		plan.ScheduleExpression = types.StringValue(echoResp.CreateTimerNode.ScheduleExpression)
 		plan.SendMessageType = types.StringValue(echoResp.CreateTimerNode.SendMessageType.Name)
 	}
@@ -97,7 +95,7 @@ func (r *TimerNodeResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *TimerNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state timerNodeModel
+	var state timerNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -106,12 +104,24 @@ func (r *TimerNodeResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting TimerNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting TimerNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for TimerNode to be deleted", err.Error())
+	}
 }
 
 func (r *TimerNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -123,7 +133,7 @@ func (r *TimerNodeResource) Metadata(ctx context.Context, req resource.MetadataR
 }
 
 func (r *TimerNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state timerNodeModel
+	var state timerNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -133,7 +143,11 @@ func (r *TimerNodeResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading TimerNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading TimerNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -141,14 +155,7 @@ func (r *TimerNodeResource) Read(ctx context.Context, req resource.ReadRequest,
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeTimerNode:
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			state.Name = types.StringValue(node.Name)
-			state.ScheduleExpression = types.StringValue(node.ScheduleExpression)
-			state.SendMessageType = types.StringValue(node.SendMessageType.Name)
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected TimerNode",
@@ -175,23 +182,26 @@ func (r *TimerNodeResource) Schema(ctx context.Context, req resource.SchemaReque
 				Required:            true,
 				Validators:          common.NameValidators,
 			},
+			"next_fire_times": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: nextFireTimesDescription,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
 			"schedule_expression": schema.StringAttribute{
-				MarkdownDescription: "An [Amazon Event Bridge cron expression]" +
-					"(https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-rule-schedule.html#eb-cron-expressions).",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Required:      true,
-				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^(?P<minute>\*|(?:\*|(?:[0-9]|(?:[1-5][0-9])))\/(?:[0-9]|(?:[1-5][0-9]))|(?:[0-9]|(?:[1-5][0-9]))(?:(?:\-[0-9]|\-(?:[1-5][0-9]))?|(?:\,(?:[0-9]|(?:[1-5][0-9])))*)) (?P<hour>\*|(?:\*|(?:\*|(?:[0-9]|1[0-9]|2[0-3])))\/(?:[0-9]|1[0-9]|2[0-3])|(?:[0-9]|1[0-9]|2[0-3])(?:(?:\-(?:[0-9]|1[0-9]|2[0-3]))?|(?:\,(?:[0-9]|1[0-9]|2[0-3]))*)) (?P<day_of_month>\*|\?|L(?:W|\-(?:[1-9]|(?:[12][0-9])|3[01]))?|(?:[1-9]|(?:[12][0-9])|3[01])(?:W|\/(?:[1-9]|(?:[12][0-9])|3[01]))?|(?:[1-9]|(?:[12][0-9])|3[01])(?:(?:\-(?:[1-9]|(?:[12][0-9])|3[01]))?|(?:\,(?:[1-9]|(?:[12][0-9])|3[01]))*)) (?P<month>\*|(?:[1-9]|1[012]|JAN|FEB|MAR|APR|MAY|JUN|JUL|AUG|SEP|OCT|NOV|DEC)(?:(?:\-(?:[1-9]|1[012]|JAN|FEB|MAR|APR|MAY|JUN|JUL|AUG|SEP|OCT|NOV|DEC))?|(?:\,(?:[1-9]|1[012]|JAN|FEB|MAR|APR|MAY|JUN|JUL|AUG|SEP|OCT|NOV|DEC))*)) (?P<day_of_week>\*|\?|[0-6](?:L|\#[1-5])?|(?:[0-6]|SUN|MON|TUE|WED|THU|FRI|SAT)(?:(?:\-(?:[0-6]|SUN|MON|TUE|WED|THU|FRI|SAT))?|(?:\,(?:[0-6]|SUN|MON|TUE|WED|THU|FRI|SAT))*)) (?P<year>\*|(?:[1-9][0-9]{3})(?:(?:\-[1-9][0-9]{3})?|(?:\,[1-9][0-9]{3})*))$`),
-						"value must match the cron format for an AWS EventBridge schedule rule (https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-rule-schedule.html#eb-cron-expressions)",
-					),
-				},
+				MarkdownDescription: scheduleExpressionDescription,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				Validators:          []validator.String{common.ScheduleExpressionValidator},
 			},
 			"send_message_type": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The MessageType that this Node is capable of sending.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[TimerNodes](https://docs.echo.stream/docs/timer-node) emit echo.timer messages on a time " +
 			"period defined by the scheduleExpression. They can be used to cause other Nodes (normally ProcessorNodes) to " +
 			"perform complex actions on a schedule (e.g. - polling an API every 15 minutes).",
@@ -199,7 +209,7 @@ func (r *TimerNodeResource) Schema(ctx context.Context, req resource.SchemaReque
 }
 
 func (r *TimerNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan timerNodeModel
+	var plan timerNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -208,6 +218,16 @@ func (r *TimerNodeResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var description *string
 	if !(plan.Description.IsNull() || plan.Description.IsUnknown()) {
 		temp := plan.Description.ValueString()
@@ -221,7 +241,7 @@ func (r *TimerNodeResource) Update(ctx context.Context, req resource.UpdateReque
 		r.data.Tenant,
 		description,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating TimerNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating TimerNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find TimerNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
@@ -235,6 +255,10 @@ func (r *TimerNodeResource) Update(ctx context.Context, req resource.UpdateReque
 				plan.Description = types.StringNull()
 			}
 			plan.Name = types.StringValue(node.Update.Name)
+			// next_fire_times is kept from the state, unless the state has none
+			if plan.NextFireTimes.IsUnknown() {
+				plan.NextFireTimes = nextFireTimes(node.Update.ScheduleExpression)
+			}
 			plan.ScheduleExpression = types.StringValue(node.Update.ScheduleExpression)
 			plan.SendMessageType = types.StringValue(node.Update.SendMessageType.Name)
 		default:
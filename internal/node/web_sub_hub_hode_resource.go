@@ -3,10 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -65,22 +65,9 @@ type WebSubHubNodeResource struct {
 	data *common.ProviderData
 }
 
-type webSubHubNodeModel struct {
-	Config                  common.Config `tfsdk:"config"`
-	DefaultLeaseSeconds     types.Int64   `tfsdk:"default_lease_seconds"`
-	DeliveryRetries         types.Int64   `tfsdk:"delivery_retries"`
-	Description             types.String  `tfsdk:"description"`
-	Endpoint                types.String  `tfsdk:"endpoint"`
-	Id                      types.String  `tfsdk:"id"`
-	InlineApiAuthenticator  types.String  `tfsdk:"inline_api_authenticator"`
-	LoggingLevel            types.String  `tfsdk:"logging_level"`
-	ManagedApiAuthenticator types.String  `tfsdk:"managed_api_authenticator"`
-	MaxLeaseSeconds         types.Int64   `tfsdk:"max_lease_seconds"`
-	Name                    types.String  `tfsdk:"name"`
-	ReceiveMessageType      types.String  `tfsdk:"receive_message_type"`
-	Requirements            types.Set     `tfsdk:"requirements"`
-	SignatureAlgorithm      types.String  `tfsdk:"signature_algorithm"`
-	SubscriptionSecurity    types.String  `tfsdk:"subscription_security"`
+type webSubHubNodeResourceModel struct {
+	webSubHubNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *WebSubHubNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -113,7 +100,7 @@ func (r *WebSubHubNodeResource) ConfigValidators(ctx context.Context) []resource
 }
 
 func (r *WebSubHubNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan webSubHubNodeModel
+	var plan webSubHubNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -198,7 +185,7 @@ func (r *WebSubHubNodeResource) Create(ctx context.Context, req resource.CreateR
 		signatureAlgorithm,
 		subscriptionSecurity,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating WebSubHubNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating WebSubHubNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.CreateWebSubHubNode.Config != nil {
@@ -262,7 +249,7 @@ func (r *WebSubHubNodeResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *WebSubHubNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state webSubHubNodeModel
+	var state webSubHubNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -271,12 +258,24 @@ func (r *WebSubHubNodeResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting WebSubHubNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting WebSubHubNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for WebSubHubNode to be deleted", err.Error())
+	}
 }
 
 func (r *WebSubHubNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -288,10 +287,7 @@ func (r *WebSubHubNodeResource) Metadata(ctx context.Context, req resource.Metad
 }
 
 func (r *WebSubHubNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var (
-		diags diag.Diagnostics
-		state webSubHubNodeModel
-	)
+	var state webSubHubNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -301,7 +297,11 @@ func (r *WebSubHubNodeResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading WebSubHubNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading WebSubHubNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -309,60 +309,7 @@ func (r *WebSubHubNodeResource) Read(ctx context.Context, req resource.ReadReque
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeWebSubHubNode:
-			if node.Config != nil {
-				state.Config = common.ConfigValue(*node.Config)
-			} else {
-				state.Config = common.ConfigNull()
-			}
-			state.DefaultLeaseSeconds = types.Int64Value(int64(node.DefaultLeaseSeconds))
-			if node.DeliveryRetries != nil {
-				state.DeliveryRetries = types.Int64Value(int64(*node.DeliveryRetries))
-			} else {
-				state.DeliveryRetries = types.Int64Null()
-			}
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			state.Endpoint = types.StringValue(node.Endpoint)
-			state.Id = types.StringValue(node.Name)
-			if node.InlineApiAuthenticator != nil {
-				state.InlineApiAuthenticator = types.StringValue(*node.InlineApiAuthenticator)
-			} else {
-				state.InlineApiAuthenticator = types.StringNull()
-			}
-			if node.LoggingLevel != nil {
-				state.LoggingLevel = types.StringValue(string(*node.LoggingLevel))
-			} else {
-				state.LoggingLevel = types.StringNull()
-			}
-			if node.ManagedApiAuthenticator != nil {
-				state.ManagedApiAuthenticator = types.StringValue(node.ManagedApiAuthenticator.Name)
-			} else {
-				state.ManagedApiAuthenticator = types.StringNull()
-			}
-			state.MaxLeaseSeconds = types.Int64Value(int64(node.MaxLeaseSeconds))
-			state.Name = types.StringValue(node.Name)
-			state.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
-			if len(node.Requirements) > 0 {
-				elems := []attr.Value{}
-				for _, req := range node.Requirements {
-					elems = append(elems, types.StringValue(req))
-				}
-				state.Requirements, diags = types.SetValue(types.StringType, elems)
-				if diags != nil && diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-				}
-			} else {
-				state.Requirements = types.SetNull(types.StringType)
-			}
-			state.SignatureAlgorithm = types.StringValue(string(node.SignatureAlgorithm))
-			if node.SubscriptionSecurity != nil {
-				state.SubscriptionSecurity = types.StringValue(string(*node.SubscriptionSecurity))
-			} else {
-				state.SubscriptionSecurity = types.StringNull()
-			}
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected WebSubHubNode",
@@ -383,6 +330,7 @@ func (r *WebSubHubNodeResource) Schema(ctx context.Context, req resource.SchemaR
 				CustomType:          common.ConfigType{},
 				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{common.ConfigDiffPlanModifier},
 				Sensitive:           true,
 			},
 			"default_lease_seconds": schema.Int64Attribute{
@@ -484,13 +432,16 @@ func (r *WebSubHubNodeResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[WebSubHubNodes](https://docs.echo.stream/docs/websub-hub) implement the W3C [WebSub](https://www.w3.org/TR/websub/) Hub feature." +
 			" They accept echo.websub messages that contain content that requires publishing to subscribers.",
 	}
 }
 
 func (r *WebSubHubNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan webSubHubNodeModel
+	var plan webSubHubNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -499,6 +450,16 @@ func (r *WebSubHubNodeResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config                  *string
 		defaultLeaseSeconds     *int
@@ -578,7 +539,7 @@ func (r *WebSubHubNodeResource) Update(ctx context.Context, req resource.UpdateR
 		signatureAlgorithm,
 		subscriptionSecurity,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating WebSubHubNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating WebSubHubNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find WebSubHubNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
@@ -0,0 +1,112 @@
+package node
+
+import (
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+type webSubHubNodeModel struct {
+	Config                  common.Config `tfsdk:"config"`
+	DefaultLeaseSeconds     types.Int64   `tfsdk:"default_lease_seconds"`
+	DeliveryRetries         types.Int64   `tfsdk:"delivery_retries"`
+	Description             types.String  `tfsdk:"description"`
+	Endpoint                types.String  `tfsdk:"endpoint"`
+	Id                      types.String  `tfsdk:"id"`
+	InlineApiAuthenticator  types.String  `tfsdk:"inline_api_authenticator"`
+	LoggingLevel            types.String  `tfsdk:"logging_level"`
+	ManagedApiAuthenticator types.String  `tfsdk:"managed_api_authenticator"`
+	MaxLeaseSeconds         types.Int64   `tfsdk:"max_lease_seconds"`
+	Name                    types.String  `tfsdk:"name"`
+	ReceiveMessageType      types.String  `tfsdk:"receive_message_type"`
+	Requirements            types.Set     `tfsdk:"requirements"`
+	SignatureAlgorithm      types.String  `tfsdk:"signature_algorithm"`
+	SubscriptionSecurity    types.String  `tfsdk:"subscription_security"`
+}
+
+func (m *webSubHubNodeModel) readNode(node *api.ReadNodeGetNodeWebSubHubNode) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Config = configValue(node.Config)
+	m.DefaultLeaseSeconds = types.Int64Value(int64(node.DefaultLeaseSeconds))
+	if node.DeliveryRetries != nil {
+		m.DeliveryRetries = types.Int64Value(int64(*node.DeliveryRetries))
+	} else {
+		m.DeliveryRetries = types.Int64Null()
+	}
+	m.Description = types.StringPointerValue(node.Description)
+	m.Endpoint = types.StringValue(node.Endpoint)
+	m.Id = types.StringValue(node.Name)
+	m.InlineApiAuthenticator = types.StringPointerValue(node.InlineApiAuthenticator)
+	m.LoggingLevel = loggingLevelValue(node.LoggingLevel)
+	if node.ManagedApiAuthenticator != nil {
+		m.ManagedApiAuthenticator = types.StringValue(node.ManagedApiAuthenticator.Name)
+	} else {
+		m.ManagedApiAuthenticator = types.StringNull()
+	}
+	m.MaxLeaseSeconds = types.Int64Value(int64(node.MaxLeaseSeconds))
+	m.Name = types.StringValue(node.Name)
+	m.ReceiveMessageType = types.StringValue(node.ReceiveMessageType.Name)
+	m.Requirements, diags = requirementsValue(node.Requirements)
+	m.SignatureAlgorithm = types.StringValue(string(node.SignatureAlgorithm))
+	if node.SubscriptionSecurity != nil {
+		m.SubscriptionSecurity = types.StringValue(string(*node.SubscriptionSecurity))
+	} else {
+		m.SubscriptionSecurity = types.StringNull()
+	}
+
+	return diags
+}
+
+func webSubHubNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := receiveNodeDataSourceAttributes()
+	maps.Copy(attributes, apiAuthenticatorDataSourceAttributes())
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"config": configDataSourceAttribute(),
+			"default_lease_seconds": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The lease duration to apply to subscription requests that do not specify hub.lease_seconds.",
+			},
+			"delivery_retries": schema.Int64Attribute{
+				Computed: true,
+				MarkdownDescription: "The number of times to attempt delivery to a subscription." +
+					" If null, the subscriptions will attempt to deliver a message for 7 days.",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The WebSubHub endpoint to give to subscribers." +
+					" Accepts POST calls using the WebSub protocol for subscriptions.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the Node.",
+			},
+			"logging_level": loggingLevelDataSourceAttribute(),
+			"max_lease_seconds": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The maximum lease duration for a subscription.",
+			},
+			"receive_message_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Will always be 'echo.websub'",
+			},
+			"requirements": requirementsDataSourceAttribute(),
+			"signature_algorithm": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The WebSub signature algorithm used by hub subscriptions when the subscription provides a secret." +
+					" One of `sha1`, `sha256`, `sha384`, or `sha512`.",
+			},
+			"subscription_security": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The security requirements the hub is enforcing on subscription requests." +
+					" One of `https`, `httpsAndSecret`, or `secret`. Null indicates no enforced subscription security.",
+			},
+		},
+	)
+	return attributes
+}
@@ -0,0 +1,77 @@
+package node
+
+import (
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+type webhookNodeModel struct {
+	Config                  common.Config `tfsdk:"config"`
+	Description             types.String  `tfsdk:"description"`
+	Endpoint                types.String  `tfsdk:"endpoint"`
+	InlineApiAuthenticator  types.String  `tfsdk:"inline_api_authenticator"`
+	LoggingLevel            types.String  `tfsdk:"logging_level"`
+	ManagedApiAuthenticator types.String  `tfsdk:"managed_api_authenticator"`
+	Name                    types.String  `tfsdk:"name"`
+	Requirements            types.Set     `tfsdk:"requirements"`
+	SendMessageType         types.String  `tfsdk:"send_message_type"`
+}
+
+func (m *webhookNodeModel) readNode(node *api.ReadNodeGetNodeWebhookNode) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Config = configValue(node.Config)
+	m.Description = types.StringPointerValue(node.Description)
+	m.Endpoint = types.StringValue(node.Endpoint)
+	m.InlineApiAuthenticator = types.StringPointerValue(node.InlineApiAuthenticator)
+	m.LoggingLevel = loggingLevelValue(node.LoggingLevel)
+	if node.ManagedApiAuthenticator != nil {
+		m.ManagedApiAuthenticator = types.StringValue(node.ManagedApiAuthenticator.Name)
+	} else {
+		m.ManagedApiAuthenticator = types.StringNull()
+	}
+	m.Name = types.StringValue(node.Name)
+	m.Requirements, diags = requirementsValue(node.Requirements)
+	m.SendMessageType = types.StringValue(node.SendMessageType.Name)
+
+	return diags
+}
+
+func apiAuthenticatorDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"inline_api_authenticator": schema.StringAttribute{
+			Computed: true,
+			MarkdownDescription: "A Python code string that contains a single top-level function definition." +
+				" This function must have the signature `(*, context, request, **kwargs)` and return" +
+				" `None` or a tuple containing an `AuthCredentials` and `BaseUser` (or subclasses).",
+		},
+		"managed_api_authenticator": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The managedApiAuthenticator.",
+		},
+	}
+}
+
+func webhookNodeDataSourceAttributes() map[string]schema.Attribute {
+	attributes := sendNodeDataSourceAttributes()
+	maps.Copy(attributes, apiAuthenticatorDataSourceAttributes())
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"config": configDataSourceAttribute(),
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The Webhooks endpoint to forward webhooks events to." +
+					" Accepts POST webhook events at the root path." +
+					" POST events may be any JSON-based payload.",
+			},
+			"logging_level": loggingLevelDataSourceAttribute(),
+			"requirements":  requirementsDataSourceAttribute(),
+		},
+	)
+	return attributes
+}
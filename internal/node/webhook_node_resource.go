@@ -3,10 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -31,16 +31,9 @@ type WebhookNodeResource struct {
 	data *common.ProviderData
 }
 
-type webhookNodeModel struct {
-	Config                  common.Config `tfsdk:"config"`
-	Description             types.String  `tfsdk:"description"`
-	Endpoint                types.String  `tfsdk:"endpoint"`
-	InlineApiAuthenticator  types.String  `tfsdk:"inline_api_authenticator"`
-	LoggingLevel            types.String  `tfsdk:"logging_level"`
-	ManagedApiAuthenticator types.String  `tfsdk:"managed_api_authenticator"`
-	Name                    types.String  `tfsdk:"name"`
-	Requirements            types.Set     `tfsdk:"requirements"`
-	SendMessageType         types.String  `tfsdk:"send_message_type"`
+type webhookNodeResourceModel struct {
+	webhookNodeModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *WebhookNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -72,7 +65,7 @@ func (r *WebhookNodeResource) ConfigValidators(ctx context.Context) []resource.C
 }
 
 func (r *WebhookNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan webhookNodeModel
+	var plan webhookNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -137,7 +130,7 @@ func (r *WebhookNodeResource) Create(ctx context.Context, req resource.CreateReq
 		requirements,
 		sendMessageType,
 	); err != nil {
-		resp.Diagnostics.AddError("Error creating WebhookNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating WebhookNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else {
 		if echoResp.CreateWebhookNode.Config != nil {
@@ -187,7 +180,7 @@ func (r *WebhookNodeResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *WebhookNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state webhookNodeModel
+	var state webhookNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -196,12 +189,24 @@ func (r *WebhookNodeResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting WebhookNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting WebhookNode", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, nodeExists(r.data, state.Name.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for WebhookNode to be deleted", err.Error())
+	}
 }
 
 func (r *WebhookNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -213,10 +218,7 @@ func (r *WebhookNodeResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *WebhookNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var (
-		diags diag.Diagnostics
-		state webhookNodeModel
-	)
+	var state webhookNodeResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -226,7 +228,11 @@ func (r *WebhookNodeResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	if echoResp, err := api.ReadNode(ctx, r.data.Client, state.Name.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading WebhookNode", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading WebhookNode", err))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.State.RemoveResource(ctx)
@@ -234,46 +240,7 @@ func (r *WebhookNodeResource) Read(ctx context.Context, req resource.ReadRequest
 	} else {
 		switch node := (*echoResp.GetNode).(type) {
 		case *api.ReadNodeGetNodeWebhookNode:
-			if node.Config != nil {
-				state.Config = common.ConfigValue(*node.Config)
-			} else {
-				state.Config = common.ConfigNull()
-			}
-			if node.Description != nil {
-				state.Description = types.StringValue(*node.Description)
-			} else {
-				state.Description = types.StringNull()
-			}
-			state.Endpoint = types.StringValue(node.Endpoint)
-			if node.InlineApiAuthenticator != nil {
-				state.InlineApiAuthenticator = types.StringValue(*node.InlineApiAuthenticator)
-			} else {
-				state.InlineApiAuthenticator = types.StringNull()
-			}
-			if node.LoggingLevel != nil {
-				state.LoggingLevel = types.StringValue(string(*node.LoggingLevel))
-			} else {
-				state.LoggingLevel = types.StringNull()
-			}
-			if node.ManagedApiAuthenticator != nil {
-				state.ManagedApiAuthenticator = types.StringValue(node.ManagedApiAuthenticator.Name)
-			} else {
-				state.ManagedApiAuthenticator = types.StringNull()
-			}
-			state.Name = types.StringValue(node.Name)
-			if len(node.Requirements) > 0 {
-				elems := []attr.Value{}
-				for _, req := range node.Requirements {
-					elems = append(elems, types.StringValue(req))
-				}
-				state.Requirements, diags = types.SetValue(types.StringType, elems)
-				if diags != nil && diags.HasError() {
-					resp.Diagnostics.Append(diags...)
-				}
-			} else {
-				state.Requirements = types.SetNull(types.StringType)
-			}
-			state.SendMessageType = types.StringValue(node.SendMessageType.Name)
+			resp.Diagnostics.Append(state.readNode(node)...)
 		default:
 			resp.Diagnostics.AddError(
 				"Expected WebhookNode",
@@ -294,6 +261,7 @@ func (r *WebhookNodeResource) Schema(ctx context.Context, req resource.SchemaReq
 				CustomType:          common.ConfigType{},
 				MarkdownDescription: "The config, in JSON object format (i.e. - dict, map).",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{common.ConfigDiffPlanModifier},
 				Sensitive:           true,
 			},
 			"description": schema.StringAttribute{
@@ -341,13 +309,16 @@ func (r *WebhookNodeResource) Schema(ctx context.Context, req resource.SchemaReq
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[WebhookNodes](https://docs.echo.stream/docs/webhook) allow for almost any processing " +
 			"of messages, including transformation, augmentation, generation, combination and splitting.",
 	}
 }
 
 func (r *WebhookNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan webhookNodeModel
+	var plan webhookNodeResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -356,6 +327,16 @@ func (r *WebhookNodeResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var (
 		config                  *string
 		description             *string
@@ -405,7 +386,7 @@ func (r *WebhookNodeResource) Update(ctx context.Context, req resource.UpdateReq
 		managedApiAuthenticator,
 		requirements,
 	); err != nil {
-		resp.Diagnostics.AddError("Error updating WebhookNode", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating WebhookNode", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	} else if echoResp.GetNode == nil {
 		resp.Diagnostics.AddError("Cannot find WebhookNode", fmt.Sprintf("'%s' Node does not exist", plan.Name.ValueString()))
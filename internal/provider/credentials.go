@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+const defaultProfile = "default"
+
+// profileKeys are the settings that may be read from a credentials file
+// profile. They are named the same as the provider attributes.
+var profileKeys = []string{
+	"access_token",
+	"appsync_endpoint",
+	"client_id",
+	"new_password",
+	"password",
+	"refresh_token",
+	"tenant",
+	"totp_secret",
+	"user_pool_id",
+	"username",
+}
+
+// defaultCredentialsFile returns ~/.echostream/credentials.
+func defaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".echostream", "credentials")
+}
+
+// readProfile reads the named profile from the credentials file at path. The
+// file is in TOML format if its extension is ".toml", and in INI format
+// otherwise, with one section per profile.
+//
+// If required is false, a missing credentials file is not an error and
+// results in an empty profile.
+func readProfile(path string, profile string, required bool) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && !required {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("unable to read credentials file: %w", err)
+	}
+	var profiles map[string]map[string]string
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		profiles, err = parseToml(content)
+	} else {
+		profiles, err = parseIni(content)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse credentials file %s: %w", path, err)
+	}
+	values, ok := profiles[profile]
+	if !ok {
+		if !required {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("profile %q not found in credentials file %s", profile, path)
+	}
+	for key := range values {
+		if !slices.Contains(profileKeys, key) {
+			return nil, fmt.Errorf("unknown setting %q in profile %q of credentials file %s", key, profile, path)
+		}
+	}
+	return values, nil
+}
+
+// parseToml parses a TOML file with one table per profile. All settings must
+// be strings, so that they are never reformatted.
+func parseToml(content []byte) (map[string]map[string]string, error) {
+	var tomlProfiles map[string]map[string]any
+	if _, err := toml.Decode(string(content), &tomlProfiles); err != nil {
+		return nil, err
+	}
+	profiles := make(map[string]map[string]string, len(tomlProfiles))
+	for name, values := range tomlProfiles {
+		profiles[name] = make(map[string]string, len(values))
+		for key, value := range values {
+			s, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("setting %q in profile %q must be a string, not %T", key, name, value)
+			}
+			profiles[name][key] = s
+		}
+	}
+	return profiles, nil
+}
+
+// parseIni parses an INI file in the style of the AWS shared credentials file.
+// Section headers may optionally be prefixed with "profile ".
+func parseIni(content []byte) (map[string]map[string]string, error) {
+	var (
+		current  map[string]string
+		profiles = map[string]map[string]string{}
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			name = strings.TrimSpace(strings.TrimPrefix(name, "profile "))
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = map[string]string{}
+			}
+			current = profiles[name]
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+			}
+			if current == nil {
+				return nil, fmt.Errorf("line %d: setting outside of a profile", lineNumber)
+			}
+			current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	return profiles, scanner.Err()
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestCredentials(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestReadProfileIni(t *testing.T) {
+	t.Parallel()
+	path := writeTestCredentials(t, "credentials", `
+# EchoStream credentials
+[default]
+tenant = dev
+
+[profile prod]
+appsync_endpoint = https://api.us-east-1.echo.stream/graphql
+client_id        = 30pseo6e4rmn4q89nttqkerhbz
+password         = ^){%^_^T#:fFr-1S=sfzQ.v
+tenant           = prod
+username         = 46a8a14b08bb4f2fb948951602d305a4
+user_pool_id     = us-east-1_qlGAO4m1H
+`)
+	profile, err := readProfile(path, "prod", true)
+	require.NoError(t, err)
+	require.Equal(t, "prod", profile["tenant"])
+	require.Equal(t, "^){%^_^T#:fFr-1S=sfzQ.v", profile["password"])
+	require.Equal(t, "us-east-1_qlGAO4m1H", profile["user_pool_id"])
+
+	profile, err = readProfile(path, defaultProfile, false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"tenant": "dev"}, profile)
+}
+
+func TestReadProfileToml(t *testing.T) {
+	t.Parallel()
+	path := writeTestCredentials(t, "credentials.toml", `
+[staging]
+tenant = "staging"
+username = "46a8a14b08bb4f2fb948951602d305a4"
+`)
+	profile, err := readProfile(path, "staging", true)
+	require.NoError(t, err)
+	require.Equal(t, "staging", profile["tenant"])
+	require.Equal(t, "46a8a14b08bb4f2fb948951602d305a4", profile["username"])
+
+	// Settings that are not strings are rejected, rather than reformatted
+	path = writeTestCredentials(t, "credentials.toml", "[staging]\nclient_id = 1e5\n")
+	_, err = readProfile(path, "staging", true)
+	require.ErrorContains(t, err, `"client_id" in profile "staging" must be a string`)
+}
+
+func TestReadProfileIniIsNotToml(t *testing.T) {
+	t.Parallel()
+	// Valid TOML, but read as INI without coercion
+	path := writeTestCredentials(t, "credentials", "[dev]\nclient_id = 1e5\ntenant = \"dev\"\n")
+	profile, err := readProfile(path, "dev", true)
+	require.NoError(t, err)
+	require.Equal(t, "1e5", profile["client_id"])
+}
+
+func TestReadProfileErrors(t *testing.T) {
+	t.Parallel()
+	missing := filepath.Join(t.TempDir(), "credentials")
+
+	profile, err := readProfile(missing, defaultProfile, false)
+	require.NoError(t, err, "a missing file is allowed when no profile was requested")
+	require.Empty(t, profile)
+
+	_, err = readProfile(missing, "prod", true)
+	require.Error(t, err)
+
+	path := writeTestCredentials(t, "credentials", "[dev]\ntenant = dev\n")
+	_, err = readProfile(path, "prod", true)
+	require.ErrorContains(t, err, `profile "prod" not found`)
+
+	path = writeTestCredentials(t, "credentials", "[dev]\ntennant = dev\n")
+	_, err = readProfile(path, "dev", true)
+	require.ErrorContains(t, err, `unknown setting "tennant"`)
+
+	path = writeTestCredentials(t, "credentials", "tenant = dev\n")
+	_, err = readProfile(path, "dev", true)
+	require.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// normalizeErrorTypes moves the errorType that AppSync returns at the top
+// level of each GraphQL error into the error's extensions. genqlient decodes
+// errors into gqlerror.Error, which only keeps the fields defined by the
+// GraphQL specification, so the errorType would otherwise be lost before it
+// can be classified by common.TranslateError.
+func normalizeErrorTypes(resp *http.Response) error {
+	body, err := bufferBody(resp)
+	if err != nil {
+		return err
+	}
+
+	var payload map[string]json.RawMessage
+	if json.Unmarshal(body, &payload) != nil || payload["errors"] == nil {
+		return nil
+	}
+	var errs []map[string]any
+	if json.Unmarshal(payload["errors"], &errs) != nil {
+		return nil
+	}
+	changed := false
+	for _, e := range errs {
+		errorType, ok := e["errorType"].(string)
+		if !ok || errorType == "" {
+			continue
+		}
+		extensions, _ := e["extensions"].(map[string]any)
+		if extensions == nil {
+			extensions = map[string]any{}
+		}
+		if _, ok := extensions["errorType"]; !ok {
+			extensions["errorType"] = errorType
+			e["extensions"] = extensions
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if payload["errors"], err = json.Marshal(errs); err != nil {
+		return err
+	}
+	if body, err = json.Marshal(payload); err != nil {
+		return err
+	}
+	resp.Body = bufferedBody{Reader: bytes.NewReader(body), Closer: resp.Body}
+	resp.ContentLength = int64(len(body))
+	return nil
+}
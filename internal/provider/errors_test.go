@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestDoNormalizesErrorTypes(t *testing.T) {
+	t.Parallel()
+	server, _ := newFailingServer(t, 1, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":null,"errors":[{"errorType":"NotFoundException","message":"gone"},{"message":"plain"}]}`))
+	})
+
+	resp := doTestRequest(t, newTestRetryDoer(0), server.URL)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var payload struct {
+		Errors gqlerror.List `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(body, &payload))
+	require.Len(t, payload.Errors, 2)
+	require.Equal(t, "NotFoundException", payload.Errors[0].Extensions["errorType"])
+	require.Equal(t, "gone", payload.Errors[0].Message)
+	require.Nil(t, payload.Errors[1].Extensions)
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultMaxConcurrentRequests = 10
+	defaultRequestsPerSecond     = 25
+)
+
+// requestLimiter limits the rate and the concurrency of requests made to the
+// EchoStream API. It is shared by every resource and data source because they
+// all use the single graphql.Client in common.ProviderData.
+type requestLimiter struct {
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+// newRequestLimiter returns a requestLimiter that allows requestsPerSecond
+// requests per second and at most maxConcurrent requests in flight. A value
+// of 0 for either disables that limit.
+func newRequestLimiter(requestsPerSecond float64, maxConcurrent int) *requestLimiter {
+	l := requestLimiter{
+		limiter: rate.NewLimiter(rate.Inf, 0),
+	}
+	if requestsPerSecond > 0 {
+		l.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), int(math.Max(1, math.Ceil(requestsPerSecond))))
+	}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	return &l
+}
+
+// acquire blocks until a request may be sent, returning a function that must
+// be called when the request has completed.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := sync.OnceFunc(func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	})
+	if err := l.limiter.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// releasingBody calls release once the response body is closed, so that a
+// request holds its slot until its response has been fully consumed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+// bufferedBody is a response body that has been read into memory so that it
+// may be inspected. Closing it closes the original body, so that a
+// releasingBody still holds its slot until the caller is done.
+type bufferedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// bufferBody reads the response body and replaces it with a bufferedBody
+// holding what was read. If reading failed, the bufferedBody fails with the
+// same error once what was read has been read again.
+func bufferBody(resp *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(resp.Body)
+	var reader io.Reader = bytes.NewReader(body)
+	if err != nil {
+		reader = io.MultiReader(reader, errorReader{err})
+	}
+	resp.Body = bufferedBody{Reader: reader, Closer: resp.Body}
+	return body, err
+}
+
+// errorReader is a reader that always fails with err.
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
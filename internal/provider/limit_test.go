@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoLimitsConcurrentRequests(t *testing.T) {
+	t.Parallel()
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	d := newTestRetryDoer(0)
+	d.limiter = newRequestLimiter(0, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, strings.NewReader(testRetryQuery))
+			require.NoError(t, err)
+			resp, err := d.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestDoHoldsSlotUntilBodyIsClosed(t *testing.T) {
+	t.Parallel()
+	server, _ := newFailingServer(t, 0, nil)
+
+	d := newTestRetryDoer(0)
+	d.limiter = newRequestLimiter(0, 1)
+
+	// The body has been read for logging and retries, but not by the caller
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, strings.NewReader(testRetryQuery))
+	require.NoError(t, err)
+	resp, err := d.Do(req)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = d.limiter.acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "the slot is held while the body is open")
+
+	require.NoError(t, resp.Body.Close())
+	release, err := d.limiter.acquire(context.Background())
+	require.NoError(t, err)
+	release()
+}
+
+func TestDoReleasesSlotAfterErroredResponses(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The body is shorter than its Content-Length, so reading it fails
+		w.Header().Set("Content-Length", "100")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":`))
+	}))
+	t.Cleanup(server.Close)
+
+	d := newTestRetryDoer(0)
+	d.limiter = newRequestLimiter(0, 2)
+
+	// More errored responses than there are slots
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(testRetryQuery))
+		require.NoError(t, err)
+		_, err = d.Do(req)
+		cancel()
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	release, err := d.limiter.acquire(ctx)
+	require.NoError(t, err, "every slot is released")
+	release()
+}
+
+func TestDoLimitsRequestRate(t *testing.T) {
+	t.Parallel()
+	server, calls := newFailingServer(t, 0, nil)
+
+	d := newTestRetryDoer(0)
+	d.limiter = newRequestLimiter(20, 0)
+
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		doTestRequest(t, d, server.URL)
+	}
+	// The first 20 requests are allowed as a burst, the next 10 at 20/s
+	require.Equal(t, int32(30), calls.Load())
+	require.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond)
+}
+
+func TestRequestLimiterHonorsContext(t *testing.T) {
+	t.Parallel()
+	l := newRequestLimiter(0, 1)
+	release, err := l.acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiLogSubsystem is the tflog subsystem for EchoStream API requests. Its
+// level follows TF_LOG_PROVIDER_ECHOSTREAM, and may be set separately with
+// TF_LOG_PROVIDER_ECHOSTREAM_API.
+const apiLogSubsystem = "api"
+
+const redacted = "***"
+
+// sensitiveValueRegexp matches the value that AppSync echoes back in
+// validation errors, such as "argument 'config' with value '...'".
+var sensitiveValueRegexp = regexp.MustCompile(`(?i)((?:argument|variable|field) '(` + strings.Join(common.SensitiveKeys, "|") + `)'[^']*?value ')(.*)(')`)
+
+type graphqlRequest struct {
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// withApiLogging returns a context for logging the GraphQL request in body
+// to the API subsystem.
+func withApiLogging(ctx context.Context, body []byte) context.Context {
+	ctx = tflog.NewSubsystem(ctx, apiLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_ECHOSTREAM", "API"))
+	var request graphqlRequest
+	if err := json.Unmarshal(body, &request); err == nil {
+		ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, "operation", request.OperationName)
+	}
+	return ctx
+}
+
+// logApiRequest logs the GraphQL request in body at TRACE, with sensitive
+// variables redacted.
+func logApiRequest(ctx context.Context, body []byte, attempt int) {
+	var request graphqlRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		tflog.SubsystemTrace(ctx, apiLogSubsystem, "Sending EchoStream API request", map[string]any{"attempt": attempt})
+		return
+	}
+	variables, _ := json.Marshal(redact(request.Variables))
+	tflog.SubsystemTrace(ctx, apiLogSubsystem, "Sending EchoStream API request", map[string]any{
+		"attempt":   attempt,
+		"variables": string(variables),
+	})
+}
+
+// logApiResponse logs the outcome of a single request at DEBUG, including
+// any GraphQL errors that were returned. The response body is restored so
+// that it may still be read by the caller.
+func logApiResponse(ctx context.Context, resp *http.Response, err error, attempt int, latency time.Duration) {
+	fields := map[string]any{
+		"attempt":    attempt,
+		"latency_ms": latency.Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "EchoStream API request failed", fields)
+		return
+	}
+	fields["status"] = resp.StatusCode
+	if requestId := requestId(resp.Header); requestId != "" {
+		fields["request_id"] = requestId
+	}
+	body, readErr := bufferBody(resp)
+	if readErr == nil {
+		if messages := errorMessages(body); len(messages) > 0 {
+			fields["errors"] = messages
+		}
+	}
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Received EchoStream API response", fields)
+}
+
+// errorMessages returns the messages of the GraphQL errors in body, each
+// prefixed with its errorType if there is one.
+func errorMessages(body []byte) []string {
+	var payload graphqlErrorPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil
+	}
+	messages := make([]string, 0, len(payload.Errors))
+	for _, e := range payload.Errors {
+		message := sensitiveValueRegexp.ReplaceAllString(e.Message, "${1}"+redacted+"${4}")
+		if e.ErrorType != "" {
+			message = e.ErrorType + ": " + message
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// redact returns a copy of value with the values of sensitive keys replaced,
+// at any depth.
+func redact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			if common.IsSensitiveKey(key) && item != nil {
+				out[key] = redacted
+				continue
+			}
+			out[key] = redact(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = redact(item)
+		}
+		return out
+	}
+	return value
+}
+
+// requestId returns the AppSync request id from the response headers.
+func requestId(header http.Header) string {
+	for _, name := range []string{"X-Amzn-Requestid", "X-Amz-Request-Id", "X-Request-Id"} {
+		if value := header.Get(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
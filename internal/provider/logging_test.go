@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+	redactedValue := redact(map[string]any{
+		"name":   "node",
+		"config": `{"secret":"value"}`,
+		"credentials": map[string]any{
+			"password": "hunter2",
+		},
+		"nested": []any{
+			map[string]any{"SecretAccessKey": "key", "description": "kept"},
+		},
+		"password": nil,
+	})
+	require.Equal(t, map[string]any{
+		"name":        "node",
+		"config":      redacted,
+		"credentials": redacted,
+		"nested": []any{
+			map[string]any{"SecretAccessKey": redacted, "description": "kept"},
+		},
+		"password": nil,
+	}, redactedValue)
+}
+
+func TestErrorMessagesRedactsEchoedValues(t *testing.T) {
+	t.Parallel()
+	messages := errorMessages([]byte(`{"errors":[{"errorType":"ValidationError","message":"Validation error of type WrongType: argument 'config' with value 'StringValue{value='{\"secret\":1}'}' is not valid"}]}`))
+	require.Len(t, messages, 1)
+	require.True(t, strings.HasPrefix(messages[0], "ValidationError: "))
+	require.NotContains(t, messages[0], "secret")
+}
+
+func TestDoLogsRequests(t *testing.T) {
+	t.Parallel()
+	const query = `{"query":"mutation CreateApiUser($password: String!) { CreateApiUser }","operationName":"CreateApiUser","variables":{"password":"hunter2","role":"admin"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Amzn-RequestId", "request-1")
+		_, _ = w.Write([]byte(`{"data":null,"errors":[{"errorType":"Unauthorized","message":"Not Authorized"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(query))
+	require.NoError(t, err)
+	resp, err := newTestRetryDoer(0).Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.NotContains(t, output.String(), "hunter2")
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "trace", entries[0]["@level"])
+	require.Equal(t, "CreateApiUser", entries[0]["operation"])
+	require.Equal(t, `{"password":"***","role":"admin"}`, entries[0]["variables"])
+	require.Equal(t, "debug", entries[1]["@level"])
+	require.Equal(t, float64(http.StatusOK), entries[1]["status"])
+	require.Equal(t, "request-1", entries[1]["request_id"])
+	require.Equal(t, []any{"Unauthorized: Not Authorized"}, entries[1]["errors"])
+}
@@ -1,16 +1,21 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/app"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common/validators"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/edge"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/function"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/kmskey"
@@ -25,11 +30,19 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	cognitoIdp "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	cognitoIdp_types "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	providerFunction "github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 )
 
@@ -38,72 +51,239 @@ var (
 	_ graphql.Doer = &echoStreamApiDoer{}
 
 	// Ensure EchoStreamProvider satisfies various provider interfaces.
-	_ provider.Provider = &echoStreamProvider{}
+	_ provider.Provider                       = &echoStreamProvider{}
+	_ provider.ProviderWithEphemeralResources = &echoStreamProvider{}
+	_ provider.ProviderWithFunctions          = &echoStreamProvider{}
 )
 
 type echoStreamApiDoer struct {
 	sync.Mutex
 	accessToken  *string
+	auth         *EchoStreamProviderModel
+	authErr      error
 	cidp         *cognitoIdp.Client
 	clientId     string
 	expiration   time.Time
+	httpClient   *http.Client
+	limiter      *requestLimiter
+	maxBackoff   time.Duration
+	maxRetries   int
 	refreshToken *string
+	tokenCache   *tokenCache
 }
 
 func (d *echoStreamApiDoer) getToken(ctx context.Context) (*string, error) {
-	if time.Now().After(d.expiration) {
-		d.Lock()
-		defer d.Unlock()
-		// Access token has expired, refresh
-		if time.Now().After(d.expiration) {
-			resp, err := d.cidp.InitiateAuth(
-				ctx,
-				&cognitoIdp.InitiateAuthInput{
-					AuthFlow: cognitoIdp_types.AuthFlowTypeRefreshTokenAuth,
-					AuthParameters: map[string]string{
-						string(cognitoIdp_types.AuthFlowTypeRefreshToken): *d.refreshToken,
-					},
-					ClientId: aws.String(d.clientId),
-				},
-			)
-			if err != nil {
-				return nil, err
-			}
-			token, err := jwt.ParseInsecure([]byte(*resp.AuthenticationResult.AccessToken))
-			if err != nil {
-				return nil, err
+	d.Lock()
+	defer d.Unlock()
+	// Rejected credentials are not retried, to avoid locking out the ApiUser
+	if d.authErr != nil {
+		return nil, d.authErr
+	}
+	if d.accessToken == nil {
+		// Authenticate on first use
+		if err := d.authenticate(ctx); err != nil {
+			if isCredentialsRejected(err) {
+				d.authErr = err
 			}
-			d.accessToken = resp.AuthenticationResult.AccessToken
-			d.refreshToken = resp.AuthenticationResult.RefreshToken
-			d.expiration = token.Expiration()
+			return nil, err
+		}
+	} else if time.Now().After(d.expiration) {
+		// Access token has expired, refresh
+		if err := d.refresh(ctx); err != nil {
+			return nil, err
 		}
 	}
 	return d.accessToken, nil
 }
 
-func newEchoStreamDoer(ctx context.Context, data *EchoStreamProviderModel) (*echoStreamApiDoer, error) {
-	d := echoStreamApiDoer{
-		clientId: data.ClientId.ValueString(),
+// credentialsError is an authentication error caused by the provider
+// configuration, which will not succeed if retried.
+type credentialsError struct {
+	error
+}
+
+func (e credentialsError) Unwrap() error {
+	return e.error
+}
+
+// isCredentialsRejected returns true if err shows that the configured
+// credentials are incomplete or were rejected by Cognito, rather than that
+// authentication failed for a transient reason (e.g. - a network error,
+// throttling or a canceled context).
+func isCredentialsRejected(err error) bool {
+	var (
+		credentialsErr  credentialsError
+		notAuthorized   *cognitoIdp_types.NotAuthorizedException
+		userNotFoundErr *cognitoIdp_types.UserNotFoundException
+	)
+	return errors.As(err, &credentialsErr) || errors.As(err, &notAuthorized) || errors.As(err, &userNotFoundErr)
+}
+
+// refresh obtains a new access token using the refresh token.
+func (d *echoStreamApiDoer) refresh(ctx context.Context) error {
+	if d.refreshToken == nil {
+		return errors.New("the access token has expired and no refresh token is configured")
 	}
-	csrp, err := cognitosrp.NewCognitoSRP(
-		data.Username.ValueString(),
-		data.Password.ValueString(),
-		data.UserPoolId.ValueString(),
-		data.ClientId.ValueString(),
-		nil,
+	resp, err := d.cidp.InitiateAuth(
+		ctx,
+		&cognitoIdp.InitiateAuthInput{
+			AuthFlow: cognitoIdp_types.AuthFlowTypeRefreshTokenAuth,
+			AuthParameters: map[string]string{
+				string(cognitoIdp_types.AuthFlowTypeRefreshToken): *d.refreshToken,
+			},
+			ClientId: aws.String(d.clientId),
+		},
 	)
+	if err != nil {
+		return err
+	}
+	return d.setAuthenticationResult(ctx, resp.AuthenticationResult)
+}
+
+// setAuthenticationResult stores the tokens from a successful authentication
+// in the doer and, if enabled, the token cache.
+func (d *echoStreamApiDoer) setAuthenticationResult(ctx context.Context, result *cognitoIdp_types.AuthenticationResultType) error {
+	token, err := jwt.ParseInsecure([]byte(*result.AccessToken))
+	if err != nil {
+		return err
+	}
+	d.accessToken = result.AccessToken
+	d.expiration = token.Expiration()
+	// Refreshing does not return a new refresh token
+	if result.RefreshToken != nil {
+		d.refreshToken = result.RefreshToken
+	}
+	if d.tokenCache != nil {
+		if err := d.tokenCache.store(cachedToken{
+			AccessToken:  *d.accessToken,
+			Expiration:   d.expiration,
+			RefreshToken: aws.ToString(d.refreshToken),
+		}); err != nil {
+			tflog.Warn(ctx, "Unable to write token cache", map[string]any{"error": err.Error()})
+		}
+	}
+	return nil
+}
+
+// useCachedToken initializes the doer from the token cache. It returns false
+// if there is no usable cached token.
+func (d *echoStreamApiDoer) useCachedToken(ctx context.Context) bool {
+	cached, err := d.tokenCache.load()
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read token cache", map[string]any{"error": err.Error()})
+		return false
+	}
+	if cached == nil || cached.RefreshToken == "" {
+		return false
+	}
+	d.refreshToken = aws.String(cached.RefreshToken)
+	if time.Now().Add(time.Minute).Before(cached.Expiration) {
+		d.accessToken = aws.String(cached.AccessToken)
+		d.expiration = cached.Expiration
+		return true
+	}
+	if err := d.refresh(ctx); err != nil {
+		tflog.Debug(ctx, "Unable to refresh cached token", map[string]any{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
+// newEchoStreamDoer returns a doer for the EchoStream API configured by data.
+// No requests are made until the doer is first used, so that operations that
+// do not need the API (e.g. - validation) work without credentials or network
+// access.
+func newEchoStreamDoer(data *EchoStreamProviderModel, version string) (*echoStreamApiDoer, error) {
+	d := echoStreamApiDoer{
+		auth:       data,
+		clientId:   data.ClientId.ValueString(),
+		maxBackoff: defaultMaxBackoff,
+		maxRetries: defaultMaxRetries,
+	}
+	if !data.MaxBackoff.IsNull() {
+		maxBackoff, err := time.ParseDuration(data.MaxBackoff.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		d.maxBackoff = maxBackoff
+	}
+	if !data.MaxRetries.IsNull() {
+		d.maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	maxConcurrentRequests := defaultMaxConcurrentRequests
+	if !data.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(data.MaxConcurrentRequests.ValueInt64())
+	}
+	requestsPerSecond := float64(defaultRequestsPerSecond)
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+	d.limiter = newRequestLimiter(requestsPerSecond, maxConcurrentRequests)
+	httpClient, err := newHttpClient(data.Http, version)
 	if err != nil {
 		return nil, err
 	}
+	d.httpClient = httpClient
+	return &d, nil
+}
+
+// authenticate obtains the initial access token using the configured
+// credentials. It must be called with the doer locked.
+func (d *echoStreamApiDoer) authenticate(ctx context.Context) error {
+	data := d.auth
+	// use a pre-issued access token, which cannot be refreshed without a refresh token
+	if !data.AccessToken.IsNull() {
+		token, err := jwt.ParseInsecure([]byte(data.AccessToken.ValueString()))
+		if err != nil {
+			return credentialsError{fmt.Errorf("the access_token is not a valid JWT: %w", err)}
+		}
+		d.accessToken = aws.String(data.AccessToken.ValueString())
+		d.expiration = token.Expiration()
+		if data.RefreshToken.IsNull() {
+			return nil
+		}
+	}
 	// configure cognito identity provider
 	cfg, err := config.LoadDefaultConfig(
 		ctx,
+		config.WithHTTPClient(d.httpClient),
 		config.WithRegion(strings.Split(data.UserPoolId.ValueString(), "_")[0]),
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	d.cidp = cognitoIdp.NewFromConfig(cfg)
+	// use a pre-issued refresh token, skipping SRP
+	if !data.RefreshToken.IsNull() {
+		d.refreshToken = aws.String(data.RefreshToken.ValueString())
+		if d.accessToken == nil {
+			if err := d.refresh(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	csrp, err := cognitosrp.NewCognitoSRP(
+		data.Username.ValueString(),
+		data.Password.ValueString(),
+		data.UserPoolId.ValueString(),
+		data.ClientId.ValueString(),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	if !data.TokenCacheFile.IsNull() {
+		d.tokenCache = newTokenCache(
+			data.TokenCacheFile.ValueString(),
+			data.UserPoolId.ValueString(),
+			data.ClientId.ValueString(),
+			data.Username.ValueString(),
+		)
+		if d.useCachedToken(ctx) {
+			return nil
+		}
+	}
 	// initiate auth
 	resp, err := d.cidp.InitiateAuth(ctx, &cognitoIdp.InitiateAuthInput{
 		AuthFlow:       cognitoIdp_types.AuthFlowTypeUserSrpAuth,
@@ -111,40 +291,136 @@ func newEchoStreamDoer(ctx context.Context, data *EchoStreamProviderModel) (*ech
 		AuthParameters: csrp.GetAuthParams(),
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	// respond to password verifier challenge
-	if resp.ChallengeName == cognitoIdp_types.ChallengeNameTypePasswordVerifier {
-		challengeResponses, _ := csrp.PasswordVerifierChallenge(resp.ChallengeParameters, time.Now())
+	// respond to challenges until authenticated
+	challengeName := resp.ChallengeName
+	challengeParameters := resp.ChallengeParameters
+	session := resp.Session
+	for {
+		var challengeResponses map[string]string
+		// USER_ID_FOR_SRP is the username as Cognito knows it, which may differ from the one provided
+		username := challengeParameters["USER_ID_FOR_SRP"]
+		if username == "" {
+			username = data.Username.ValueString()
+		}
+		switch challengeName {
+		case cognitoIdp_types.ChallengeNameTypePasswordVerifier:
+			if challengeResponses, err = csrp.PasswordVerifierChallenge(challengeParameters, time.Now()); err != nil {
+				return err
+			}
+		case cognitoIdp_types.ChallengeNameTypeSoftwareTokenMfa:
+			if data.TotpSecret.IsNull() {
+				return credentialsError{errors.New("the ApiUser requires software token MFA, but totp_secret is not configured")}
+			}
+			code, err := totpCode(data.TotpSecret.ValueString(), time.Now())
+			if err != nil {
+				return credentialsError{err}
+			}
+			challengeResponses = map[string]string{
+				"SOFTWARE_TOKEN_MFA_CODE": code,
+				"USERNAME":                username,
+			}
+		case cognitoIdp_types.ChallengeNameTypeNewPasswordRequired:
+			if data.NewPassword.IsNull() {
+				return credentialsError{errors.New("the ApiUser must change their password, but new_password is not configured")}
+			}
+			challengeResponses = map[string]string{
+				"NEW_PASSWORD": data.NewPassword.ValueString(),
+				"USERNAME":     username,
+			}
+		default:
+			return errors.New("Invalid challenge: " + string(challengeName))
+		}
 
 		resp, err := d.cidp.RespondToAuthChallenge(ctx, &cognitoIdp.RespondToAuthChallengeInput{
-			ChallengeName:      cognitoIdp_types.ChallengeNameTypePasswordVerifier,
+			ChallengeName:      challengeName,
 			ChallengeResponses: challengeResponses,
 			ClientId:           aws.String(csrp.GetClientId()),
+			Session:            session,
 		})
 		if err != nil {
-			return nil, err
+			return err
+		}
+		if challengeName == cognitoIdp_types.ChallengeNameTypeNewPasswordRequired {
+			tflog.Warn(ctx, "ApiUser password changed to new_password, update the configured password")
 		}
 
-		token, err := jwt.ParseInsecure([]byte(*resp.AuthenticationResult.AccessToken))
+		if resp.AuthenticationResult != nil {
+			return d.setAuthenticationResult(ctx, resp.AuthenticationResult)
+		}
+		challengeName = resp.ChallengeName
+		challengeParameters = resp.ChallengeParameters
+		session = resp.Session
+	}
+}
+
+// Do sends req to the EchoStream API, retrying throttled requests and
+// transient failures with exponential backoff and jitter.
+func (d *echoStreamApiDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	// Buffer the body so that it may be resent on retry
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+	ctx = withApiLogging(ctx, body)
+	idempotent := !isMutation(body)
+	for attempt := 0; ; attempt++ {
+		token, err := d.getToken(ctx)
 		if err != nil {
 			return nil, err
 		}
-		d.accessToken = resp.AuthenticationResult.AccessToken
-		d.expiration = token.Expiration()
-		d.refreshToken = resp.AuthenticationResult.RefreshToken
-		return &d, nil
+		attemptReq := req.Clone(ctx)
+		attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		attemptReq.Header.Set("Authorization", *token)
+		release, err := d.limiter.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		logApiRequest(ctx, body, attempt)
+		start := time.Now()
+		resp, err := d.httpClient.Do(attemptReq)
+		if err != nil {
+			release()
+		} else {
+			resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+		}
+		logApiResponse(ctx, resp, err, attempt, time.Since(start))
+		retry, retryAfter := shouldRetry(resp, err, idempotent)
+		if !retry || attempt >= d.maxRetries {
+			if err == nil {
+				if err = normalizeErrorTypes(resp); err != nil {
+					resp.Body.Close()
+					return nil, err
+				}
+			}
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		wait := backoff(attempt, retryAfter, d.maxBackoff)
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "Retrying EchoStream API request", map[string]any{"attempt": attempt, "backoff": wait.String()})
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
-	return nil, errors.New("Invalid challenge: " + string(resp.ChallengeName))
 }
 
-func (d *echoStreamApiDoer) Do(req *http.Request) (*http.Response, error) {
-	if token, err := d.getToken(req.Context()); err != nil {
-		return nil, err
-	} else {
-		req.Header.Set("Authorization", *token)
+// missingConfigurationError converts the diagnostics for missing provider
+// settings into the error returned by API requests.
+func missingConfigurationError(missing diag.Diagnostics) error {
+	messages := []string{"The EchoStream API cannot be used because the provider is not fully configured."}
+	for _, d := range missing.Errors() {
+		messages = append(messages, d.Summary()+": "+d.Detail())
 	}
-	return http.DefaultClient.Do(req)
+	return errors.New(strings.Join(messages, "\n\n"))
 }
 
 // echoStreamProvider defines the provider implementation.
@@ -153,6 +429,8 @@ type echoStreamProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// wrapDoer, if set, wraps the doer used for API requests.
+	wrapDoer func(graphql.Doer) graphql.Doer
 }
 
 func (p *echoStreamProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -161,20 +439,56 @@ func (p *echoStreamProvider) DataSources(ctx context.Context) []func() datasourc
 		func() datasource.DataSource { return &app.ExternalAppDataSource{} },
 		func() datasource.DataSource { return &app.ManagedAppDataSource{} },
 		func() datasource.DataSource { return &edge.EdgeDataSource{} },
+		func() datasource.DataSource { return &edge.EdgesDataSource{} },
 		func() datasource.DataSource { return &function.ApiAuthenticatorFunctionDataSource{} },
 		func() datasource.DataSource { return &function.BitmapperFunctionDataSource{} },
 		func() datasource.DataSource { return &function.ProcessorFunctionDataSource{} },
+		func() datasource.DataSource { return &kmskey.KmsKeyDataSource{} },
 		func() datasource.DataSource { return &managed_node_type.ManagedNodeTypeDataSource{} },
 		func() datasource.DataSource { return &message_type.MessageTypeDataSource{} },
 		func() datasource.DataSource { return &node.AlertEmitterNodeDataSource{} },
 		func() datasource.DataSource { return &node.AppChangeReceiverNodeDataSource{} },
 		func() datasource.DataSource { return &node.AppChangeRouterNodeDataSource{} },
 		func() datasource.DataSource { return &node.AuditEmitterNodeDataSource{} },
+		func() datasource.DataSource { return &node.BitmapRouterNodeDataSource{} },
 		func() datasource.DataSource { return &node.ChangeEmitterNodeDataSource{} },
+		func() datasource.DataSource { return &node.CrossTenantReceivingNodeDataSource{} },
+		func() datasource.DataSource { return &node.CrossTenantSendingNodeDataSource{} },
 		func() datasource.DataSource { return &node.DeadLetterEmitterNodeDataSource{} },
 		func() datasource.DataSource { return &node.ExternalNodeDataSource{} },
+		func() datasource.DataSource { return &node.FilesDotComWebhookNodeDataSource{} },
+		func() datasource.DataSource { return &node.LoadBalancerNodeDataSource{} },
 		func() datasource.DataSource { return &node.LogEmitterNodeDataSource{} },
+		func() datasource.DataSource { return &node.ManagedNodeDataSource{} },
+		func() datasource.DataSource { return &node.NodeDataSource{} },
+		func() datasource.DataSource { return &node.NodesDataSource{} },
+		func() datasource.DataSource { return &node.ProcessorNodeDataSource{} },
+		func() datasource.DataSource { return &node.TimerNodeDataSource{} },
+		func() datasource.DataSource { return &node.WebhookNodeDataSource{} },
+		func() datasource.DataSource { return &node.WebSubHubNodeDataSource{} },
 		func() datasource.DataSource { return &tenant.TenantDataSource{} },
+		func() datasource.DataSource { return &tenant.TenantGraphDataSource{} },
+		func() datasource.DataSource { return &user.ApiUserDataSource{} },
+		func() datasource.DataSource { return &user.TenantUserDataSource{} },
+	}
+}
+
+func (p *echoStreamProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource { return &app.AppCredentialsEphemeralResource{} },
+		func() ephemeral.EphemeralResource { return &tenant.TenantAwsCredentialsEphemeralResource{} },
+		func() ephemeral.EphemeralResource { return &user.ApiUserCredentialsEphemeralResource{} },
+	}
+}
+
+func (p *echoStreamProvider) Functions(ctx context.Context) []func() providerFunction.Function {
+	return []func() providerFunction.Function{
+		func() providerFunction.Function { return &common.ConfigSizeFunction{} },
+		func() providerFunction.Function { return &common.MergeConfigFunction{} },
+		func() providerFunction.Function { return &common.NormalizeConfigFunction{} },
+		func() providerFunction.Function { return &node.BitmapFunction{} },
+		func() providerFunction.Function { return &node.CronNextFunction{} },
+		func() providerFunction.Function { return &node.DecodeBitmapFunction{} },
 	}
 }
 
@@ -187,117 +501,301 @@ func (p *echoStreamProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	profileRequired := false
+	if data.Profile.IsNull() {
+		if profile := os.Getenv("ECHOSTREAM_PROFILE"); profile != "" {
+			data.Profile = types.StringValue(profile)
+		}
+	}
+	if data.Profile.IsNull() {
+		data.Profile = types.StringValue(defaultProfile)
+	} else {
+		profileRequired = true
+	}
+	if data.CredentialsFile.IsNull() {
+		if credentialsFile := os.Getenv("ECHOSTREAM_CREDENTIALS_FILE"); credentialsFile != "" {
+			data.CredentialsFile = types.StringValue(credentialsFile)
+		} else {
+			data.CredentialsFile = types.StringValue(defaultCredentialsFile())
+		}
+	}
+	profile, err := readProfile(data.CredentialsFile.ValueString(), data.Profile.ValueString(), profileRequired)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading credentials profile", err.Error())
+		return
+	}
+
+	if data.AccessToken.IsNull() {
+		accessToken := os.Getenv("ECHOSTREAM_ACCESS_TOKEN")
+		if accessToken == "" {
+			accessToken = profile["access_token"]
+		}
+		if accessToken != "" {
+			data.AccessToken = types.StringValue(accessToken)
+		}
+	}
+	if data.RefreshToken.IsNull() {
+		refreshToken := os.Getenv("ECHOSTREAM_REFRESH_TOKEN")
+		if refreshToken == "" {
+			refreshToken = profile["refresh_token"]
+		}
+		if refreshToken != "" {
+			data.RefreshToken = types.StringValue(refreshToken)
+		}
+	}
+	// Missing credentials are only an error once the API is used
+	var missing diag.Diagnostics
+	// Cognito is not used when only an access token is provided
+	cognitoRequired := data.AccessToken.IsNull() || !data.RefreshToken.IsNull()
+	// Username and password are not used when a token is provided
+	passwordRequired := data.AccessToken.IsNull() && data.RefreshToken.IsNull()
+
 	if data.AppsyncEndpoint.IsNull() {
 		appsyncEndpoint := os.Getenv("ECHOSTREAM_APPSYNC_ENDPOINT")
+		if appsyncEndpoint == "" {
+			appsyncEndpoint = profile["appsync_endpoint"]
+		}
 		if appsyncEndpoint != "" {
 			data.AppsyncEndpoint = types.StringValue(appsyncEndpoint)
 		} else {
-			resp.Diagnostics.AddError(
+			missing.AddError(
 				"Missing AppSync Endpoint Configuration",
 				"While configuring the provider, the AppSync Endpoint was not found in "+
-					"the ECHOSTREAM_APPSYNC_ENDPOINT environment variable or provider "+
-					"configuration block appsync_endpoint attribute.",
+					"the ECHOSTREAM_APPSYNC_ENDPOINT environment variable, provider "+
+					"configuration block appsync_endpoint attribute or credentials file profile.",
 			)
 		}
 	}
 	if data.ClientId.IsNull() {
 		clientId := os.Getenv("ECHOSTREAM_CLIENT_ID")
+		if clientId == "" {
+			clientId = profile["client_id"]
+		}
 		if clientId != "" {
 			data.ClientId = types.StringValue(clientId)
-		} else {
-			resp.Diagnostics.AddError(
+		} else if cognitoRequired {
+			missing.AddError(
 				"Missing Client ID Configuration",
-				"While configuring the provider, the AppSync Endpoint was not found in "+
-					"the ECHOSTREAM_CLIENT_ID environment variable or provider "+
-					"configuration block client_id attribute.",
+				"While configuring the provider, the Client ID was not found in "+
+					"the ECHOSTREAM_CLIENT_ID environment variable, provider "+
+					"configuration block client_id attribute or credentials file profile.",
 			)
 		}
 	}
 	if data.Password.IsNull() {
 		password := os.Getenv("ECHOSTREAM_PASSWORD")
+		if password == "" {
+			password = profile["password"]
+		}
 		if password != "" {
 			data.Password = types.StringValue(password)
-		} else {
-			resp.Diagnostics.AddError(
+		} else if passwordRequired {
+			missing.AddError(
 				"Missing Password Configuration",
-				"While configuring the provider, the AppSync Endpoint was not found in "+
-					"the ECHOSTREAM_PASSWORD environment variable or provider "+
-					"configuration block password attribute.",
+				"While configuring the provider, the Password was not found in "+
+					"the ECHOSTREAM_PASSWORD environment variable, provider "+
+					"configuration block password attribute or credentials file profile, "+
+					"and no refresh_token or access_token was provided.",
 			)
 		}
 	}
 	if data.Tenant.IsNull() {
 		tenant := os.Getenv("ECHOSTREAM_TENANT")
+		if tenant == "" {
+			tenant = profile["tenant"]
+		}
 		if tenant != "" {
 			data.Tenant = types.StringValue(tenant)
 		} else {
-			resp.Diagnostics.AddError(
+			missing.AddError(
 				"Missing Tenant Configuration",
-				"While configuring the provider, the AppSync Endpoint was not found in "+
-					"the ECHOSTREAM_TENANT environment variable or provider "+
-					"configuration block tenant attribute.",
+				"While configuring the provider, the Tenant was not found in "+
+					"the ECHOSTREAM_TENANT environment variable, provider "+
+					"configuration block tenant attribute or credentials file profile.",
 			)
 		}
 	}
 	if data.Username.IsNull() {
 		username := os.Getenv("ECHOSTREAM_USERNAME")
+		if username == "" {
+			username = profile["username"]
+		}
 		if username != "" {
 			data.Username = types.StringValue(username)
-		} else {
-			resp.Diagnostics.AddError(
+		} else if passwordRequired {
+			missing.AddError(
 				"Missing Username Configuration",
-				"While configuring the provider, the AppSync Endpoint was not found in "+
-					"the ECHOSTREAM_USERNAME environment variable or provider "+
-					"configuration block username attribute.",
+				"While configuring the provider, the Username was not found in "+
+					"the ECHOSTREAM_USERNAME environment variable, provider "+
+					"configuration block username attribute or credentials file profile, "+
+					"and no refresh_token or access_token was provided.",
 			)
 		}
 	}
 	if data.UserPoolId.IsNull() {
 		userPoolId := os.Getenv("ECHOSTREAM_USER_POOL_ID")
+		if userPoolId == "" {
+			userPoolId = profile["user_pool_id"]
+		}
 		if userPoolId != "" {
 			data.UserPoolId = types.StringValue(userPoolId)
-		} else {
-			resp.Diagnostics.AddError(
+		} else if cognitoRequired {
+			missing.AddError(
 				"Missing User Pool ID Configuration",
-				"While configuring the provider, the AppSync Endpoint was not found in "+
-					"the ECHOSTREAM_USER_POOL_ID environment variable or provider "+
-					"configuration block user_pool_id attribute.",
+				"While configuring the provider, the User Pool ID was not found in "+
+					"the ECHOSTREAM_USER_POOL_ID environment variable, provider "+
+					"configuration block user_pool_id attribute or credentials file profile.",
 			)
 		}
 	}
 
+	if data.MaxBackoff.IsNull() {
+		if maxBackoff := os.Getenv("ECHOSTREAM_MAX_BACKOFF"); maxBackoff != "" {
+			if _, err := time.ParseDuration(maxBackoff); err != nil {
+				resp.Diagnostics.AddError(
+					"Invalid Max Backoff Configuration",
+					"While configuring the provider, the ECHOSTREAM_MAX_BACKOFF environment "+
+						"variable could not be parsed as a duration: "+err.Error(),
+				)
+			} else {
+				data.MaxBackoff = types.StringValue(maxBackoff)
+			}
+		}
+	}
+	if data.MaxConcurrentRequests.IsNull() {
+		if maxConcurrentRequests := os.Getenv("ECHOSTREAM_MAX_CONCURRENT_REQUESTS"); maxConcurrentRequests != "" {
+			if value, err := strconv.ParseInt(maxConcurrentRequests, 10, 64); err != nil || value < 0 {
+				resp.Diagnostics.AddError(
+					"Invalid Max Concurrent Requests Configuration",
+					"While configuring the provider, the ECHOSTREAM_MAX_CONCURRENT_REQUESTS environment "+
+						"variable must be a non-negative integer, found "+maxConcurrentRequests,
+				)
+			} else {
+				data.MaxConcurrentRequests = types.Int64Value(value)
+			}
+		}
+	}
+	if data.MaxRetries.IsNull() {
+		if maxRetries := os.Getenv("ECHOSTREAM_MAX_RETRIES"); maxRetries != "" {
+			if value, err := strconv.ParseInt(maxRetries, 10, 64); err != nil || value < 0 {
+				resp.Diagnostics.AddError(
+					"Invalid Max Retries Configuration",
+					"While configuring the provider, the ECHOSTREAM_MAX_RETRIES environment "+
+						"variable must be a non-negative integer, found "+maxRetries,
+				)
+			} else {
+				data.MaxRetries = types.Int64Value(value)
+			}
+		}
+	}
+
+	if data.RequestsPerSecond.IsNull() {
+		if requestsPerSecond := os.Getenv("ECHOSTREAM_REQUESTS_PER_SECOND"); requestsPerSecond != "" {
+			if value, err := strconv.ParseFloat(requestsPerSecond, 64); err != nil || value < 0 {
+				resp.Diagnostics.AddError(
+					"Invalid Requests Per Second Configuration",
+					"While configuring the provider, the ECHOSTREAM_REQUESTS_PER_SECOND environment "+
+						"variable must be a non-negative number, found "+requestsPerSecond,
+				)
+			} else {
+				data.RequestsPerSecond = types.Float64Value(value)
+			}
+		}
+	}
+
+	if data.NewPassword.IsNull() {
+		newPassword := os.Getenv("ECHOSTREAM_NEW_PASSWORD")
+		if newPassword == "" {
+			newPassword = profile["new_password"]
+		}
+		if newPassword != "" {
+			data.NewPassword = types.StringValue(newPassword)
+		}
+	}
+	if data.TotpSecret.IsNull() {
+		totpSecret := os.Getenv("ECHOSTREAM_TOTP_SECRET")
+		if totpSecret == "" {
+			totpSecret = profile["totp_secret"]
+		}
+		if totpSecret != "" {
+			data.TotpSecret = types.StringValue(totpSecret)
+		}
+	}
+	if data.TokenCacheFile.IsNull() {
+		if tokenCacheFile := os.Getenv("ECHOSTREAM_TOKEN_CACHE_FILE"); tokenCacheFile != "" {
+			data.TokenCacheFile = types.StringValue(tokenCacheFile)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	doer, err := newEchoStreamDoer(ctx, &data)
+	if data.Http != nil && data.Http.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("http").AtName("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"The provider will not verify the TLS certificates of the EchoStream API or AWS Cognito. "+
+				"This should only be used for testing.",
+		)
+	}
+
+	doer, err := newEchoStreamDoer(&data, p.version)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating api connection", err.Error())
 		return
 	}
+	if missing.HasError() {
+		doer.authErr = missingConfigurationError(missing)
+		tflog.Debug(ctx, "Provider credentials are incomplete, API requests will fail", map[string]any{"error": doer.authErr.Error()})
+	}
+
+	var apiDoer graphql.Doer = doer
+	if p.wrapDoer != nil {
+		apiDoer = p.wrapDoer(doer)
+	}
 
 	// Example client configuration for data sources and resources
 	pd := common.ProviderData{
-		Client: graphql.NewClient(data.AppsyncEndpoint.ValueString(), doer),
+		Client: graphql.NewClient(data.AppsyncEndpoint.ValueString(), apiDoer),
 		Tenant: data.Tenant.ValueString(),
 	}
 	resp.DataSourceData = &pd
+	resp.EphemeralResourceData = &pd
 	resp.ResourceData = &pd
 }
 
 // EchoStreamProviderModel describes the provider data model.
 type EchoStreamProviderModel struct {
-	AppsyncEndpoint types.String `tfsdk:"appsync_endpoint"`
-	ClientId        types.String `tfsdk:"client_id"`
-	Password        types.String `tfsdk:"password"`
-	Tenant          types.String `tfsdk:"tenant"`
-	Username        types.String `tfsdk:"username"`
-	UserPoolId      types.String `tfsdk:"user_pool_id"`
+	AccessToken           types.String         `tfsdk:"access_token"`
+	AppsyncEndpoint       types.String         `tfsdk:"appsync_endpoint"`
+	ClientId              types.String         `tfsdk:"client_id"`
+	CredentialsFile       types.String         `tfsdk:"credentials_file"`
+	Http                  *EchoStreamHttpModel `tfsdk:"http"`
+	MaxBackoff            types.String         `tfsdk:"max_backoff"`
+	MaxConcurrentRequests types.Int64          `tfsdk:"max_concurrent_requests"`
+	MaxRetries            types.Int64          `tfsdk:"max_retries"`
+	NewPassword           types.String         `tfsdk:"new_password"`
+	Password              types.String         `tfsdk:"password"`
+	Profile               types.String         `tfsdk:"profile"`
+	RefreshToken          types.String         `tfsdk:"refresh_token"`
+	RequestsPerSecond     types.Float64        `tfsdk:"requests_per_second"`
+	Tenant                types.String         `tfsdk:"tenant"`
+	TokenCacheFile        types.String         `tfsdk:"token_cache_file"`
+	TotpSecret            types.String         `tfsdk:"totp_secret"`
+	Username              types.String         `tfsdk:"username"`
+	UserPoolId            types.String         `tfsdk:"user_pool_id"`
 }
 
 func (p *echoStreamProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "A pre-issued access token for the ApiUser, used instead of `username` and `password`. " +
+					"Unless `refresh_token` is also provided, the provider will fail once it expires, so this is only suitable for short jobs.",
+				Optional:  true,
+				Sensitive: true,
+			},
 			"appsync_endpoint": schema.StringAttribute{
 				MarkdownDescription: "The ApiUser's AppSync Endpoint.",
 				Optional:            true,
@@ -306,15 +804,71 @@ func (p *echoStreamProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				MarkdownDescription: "The ApiUser's AWS Cognito Client Id.",
 				Optional:            true,
 			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "The path to the credentials file, in INI format (or TOML format if its name ends in `.toml`), containing named profiles. " +
+					"Defaults to `~/.echostream/credentials`.",
+				Optional: true,
+			},
+			"max_backoff": schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait between retries of a throttled or failed API request, " +
+					"as a duration (e.g. - `30s`). Defaults to `30s`.",
+				Optional:   true,
+				Validators: []validator.String{validators.Duration()},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of API requests that may be in flight at once, " +
+					"shared by all resources and data sources. `0` disables the limit. Defaults to `10`.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times to retry a throttled or failed API request. " +
+					"`0` disables retries. Defaults to `5`.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"new_password": schema.StringAttribute{
+				MarkdownDescription: "The password to set if AWS Cognito requires the ApiUser to change their password " +
+					"(`NEW_PASSWORD_REQUIRED`). Once changed, `password` must be updated to this value.",
+				Optional:  true,
+				Sensitive: true,
+			},
 			"password": schema.StringAttribute{
 				MarkdownDescription: "The ApiUser's password.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The named profile in the credentials file to read settings from. " +
+					"Attributes and environment variables override the profile's values. Defaults to `default`.",
+				Optional: true,
+			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "A pre-issued refresh token for the ApiUser, used instead of `username` and `password`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum sustained rate of API requests per second, " +
+					"shared by all resources and data sources. `0` disables the limit. Defaults to `25`.",
+				Optional:   true,
+				Validators: []validator.Float64{float64validator.AtLeast(0)},
+			},
 			"tenant": schema.StringAttribute{
 				MarkdownDescription: "The EchoStream Tenant to manage.",
 				Optional:            true,
 			},
+			"token_cache_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a file in which to cache the ApiUser's access and refresh tokens " +
+					"between provider runs (e.g. - `~/.echostream/token_cache.json`). If not set, tokens are not cached.",
+				Optional: true,
+			},
+			"totp_secret": schema.StringAttribute{
+				MarkdownDescription: "The base32 encoded secret of the ApiUser's software token (TOTP) MFA device. " +
+					"Required if the ApiUser has software token MFA enabled.",
+				Optional:  true,
+				Sensitive: true,
+			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "The ApiUser's username.",
 				Optional:            true,
@@ -324,6 +878,32 @@ func (p *echoStreamProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"http": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"ca_bundle_file": schema.StringAttribute{
+						MarkdownDescription: "The path to a file of PEM encoded CA certificates to trust " +
+							"in addition to the system's certificate pool (e.g. - for a TLS intercepting corporate proxy).",
+						Optional: true,
+					},
+					"insecure_skip_verify": schema.BoolAttribute{
+						MarkdownDescription: "If `true`, TLS certificates are not verified. For testing only. Defaults to `false`.",
+						Optional:            true,
+					},
+					"proxy_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the proxy to send all requests through. If not set, " +
+							"the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used.",
+						Optional: true,
+					},
+					"request_timeout": schema.StringAttribute{
+						MarkdownDescription: "The maximum time a single request may take, as a duration (e.g. - `60s`). Defaults to `60s`.",
+						Optional:            true,
+						Validators:          []validator.String{validators.Duration()},
+					},
+				},
+				MarkdownDescription: "Configures the HTTP client used to connect to EchoStream and AWS Cognito.",
+			},
+		},
 	}
 }
 
@@ -335,6 +915,18 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// NewWithDoerWrapper returns a provider whose API requests are sent through
+// the graphql.Doer returned by wrap, e.g. - to record and replay them in
+// tests.
+func NewWithDoerWrapper(version string, wrap func(graphql.Doer) graphql.Doer) func() provider.Provider {
+	return func() provider.Provider {
+		return &echoStreamProvider{
+			version:  version,
+			wrapDoer: wrap,
+		}
+	}
+}
+
 func (p *echoStreamProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "echostream"
 	resp.Version = p.version
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/test/fake"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAccessToken returns a signed JWT that expires at expiration. The
+// provider does not verify the signature, so any key will do.
+func newTestAccessToken(t *testing.T, expiration time.Time) string {
+	token, err := jwt.NewBuilder().Expiration(expiration).Build()
+	require.NoError(t, err)
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.HS256, []byte("test")))
+	require.NoError(t, err)
+	return string(signed)
+}
+
+func newTestProviderModel() *EchoStreamProviderModel {
+	return &EchoStreamProviderModel{
+		AccessToken:           types.StringNull(),
+		AppsyncEndpoint:       types.StringValue("https://api.example.com/graphql"),
+		ClientId:              types.StringNull(),
+		MaxBackoff:            types.StringNull(),
+		MaxConcurrentRequests: types.Int64Null(),
+		MaxRetries:            types.Int64Null(),
+		NewPassword:           types.StringNull(),
+		Password:              types.StringNull(),
+		RefreshToken:          types.StringNull(),
+		RequestsPerSecond:     types.Float64Null(),
+		Tenant:                types.StringValue("test"),
+		TokenCacheFile:        types.StringNull(),
+		TotpSecret:            types.StringNull(),
+		Username:              types.StringNull(),
+		UserPoolId:            types.StringNull(),
+	}
+}
+
+func TestNewEchoStreamDoerWithAccessToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	accessToken := newTestAccessToken(t, expiration)
+
+	data := newTestProviderModel()
+	data.AccessToken = types.StringValue(accessToken)
+	d, err := newEchoStreamDoer(data, "test")
+	require.NoError(t, err)
+	require.Nil(t, d.cidp, "Cognito is not needed with only an access token")
+
+	token, err := d.getToken(ctx)
+	require.NoError(t, err)
+	require.Equal(t, accessToken, *token)
+
+	// An expired access token cannot be refreshed
+	d.expiration = time.Now().Add(-time.Minute)
+	_, err = d.getToken(ctx)
+	require.ErrorContains(t, err, "no refresh token")
+}
+
+func TestDoWithMissingCredentials(t *testing.T) {
+	t.Parallel()
+	server, calls := newFailingServer(t, 0, nil)
+
+	var missing diag.Diagnostics
+	missing.AddError("Missing Password Configuration", "The Password was not found.")
+	d, err := newEchoStreamDoer(newTestProviderModel(), "test")
+	require.NoError(t, err)
+	d.authErr = missingConfigurationError(missing)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, strings.NewReader(testRetryQuery))
+	require.NoError(t, err)
+	_, err = d.Do(req)
+	require.ErrorContains(t, err, "not fully configured")
+	require.ErrorContains(t, err, "Missing Password Configuration: The Password was not found.")
+	require.Equal(t, int32(0), calls.Load(), "no request is sent without credentials")
+}
+
+func TestNewEchoStreamDoerWithFakeBackend(t *testing.T) {
+	server := fake.NewServer("test")
+	t.Cleanup(server.Close)
+	for key, value := range server.Env() {
+		t.Setenv(key, value)
+	}
+
+	data := newTestProviderModel()
+	data.AppsyncEndpoint = types.StringValue(server.Endpoint())
+	data.ClientId = types.StringValue(server.ClientId())
+	data.Password = types.StringValue(server.Password)
+	data.Username = types.StringValue(server.Username)
+	data.UserPoolId = types.StringValue(server.UserPoolId())
+	d, err := newEchoStreamDoer(data, "test")
+	require.NoError(t, err)
+
+	// A failure that is not a credential rejection is retried by the next request
+	client := graphql.NewClient(server.Endpoint(), d)
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = api.ReadTenant(canceled, client, "test")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, d.authErr)
+
+	// Authenticates with SRP against the fake Cognito endpoint
+	echoResp, err := api.ReadTenant(context.Background(), client, "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", echoResp.GetTenant.Name)
+	require.NotNil(t, d.refreshToken)
+
+	// An expired access token is refreshed
+	d.expiration = time.Now().Add(-time.Minute)
+	_, err = api.ReadTenant(context.Background(), client, "test")
+	require.NoError(t, err)
+	require.True(t, d.expiration.After(time.Now()))
+
+	// The password is verified
+	data = newTestProviderModel()
+	data.ClientId = types.StringValue(server.ClientId())
+	data.Password = types.StringValue("wrong")
+	data.Username = types.StringValue(server.Username)
+	data.UserPoolId = types.StringValue(server.UserPoolId())
+	d, err = newEchoStreamDoer(data, "test")
+	require.NoError(t, err)
+	_, err = api.ReadTenant(context.Background(), graphql.NewClient(server.Endpoint(), d), "test")
+	require.ErrorContains(t, err, "NotAuthorizedException")
+	require.ErrorContains(t, d.authErr, "NotAuthorizedException", "rejected credentials are not retried")
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxBackoff = 30 * time.Second
+	defaultMaxRetries = 5
+	minBackoff        = 250 * time.Millisecond
+)
+
+// throttlingErrorTypes are the AppSync errorType values that indicate a
+// request was rejected because of rate limiting and may be retried.
+var throttlingErrorTypes = []string{
+	"LimitExceededException",
+	"ThrottlingException",
+	"TooManyRequestsException",
+}
+
+type graphqlErrorPayload struct {
+	Errors []struct {
+		ErrorType string `json:"errorType"`
+		Message   string `json:"message"`
+	} `json:"errors"`
+}
+
+// backoff returns the amount of time to wait before retry number attempt
+// (zero-based), using exponential backoff with full jitter. If the server
+// supplied a Retry-After, that is honored instead. The result never exceeds
+// maxBackoff.
+func backoff(attempt int, retryAfter time.Duration, maxBackoff time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return min(retryAfter, maxBackoff)
+	}
+	ceiling := maxBackoff
+	if attempt < 32 {
+		if exp := minBackoff << attempt; exp > 0 && exp < ceiling {
+			ceiling = exp
+		}
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// isMutation returns true if body is a GraphQL request for a mutation. Mutations
+// are not idempotent, so they may only be retried when the server certainly did
+// not act on them.
+func isMutation(body []byte) bool {
+	var request struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return false
+	}
+	return strings.HasPrefix(strings.TrimSpace(request.Query), "mutation")
+}
+
+// isNotSent returns true if err shows that a request never left the client,
+// because a connection to the server could not be made.
+func isNotSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isThrottlingPayload returns true if body is a GraphQL response whose errors
+// are all AppSync throttling errors.
+func isThrottlingPayload(body []byte) bool {
+	var payload graphqlErrorPayload
+	if err := json.Unmarshal(body, &payload); err != nil || len(payload.Errors) == 0 {
+		return false
+	}
+	for _, e := range payload.Errors {
+		throttled := false
+		for _, t := range throttlingErrorTypes {
+			if strings.HasSuffix(e.ErrorType, t) {
+				throttled = true
+				break
+			}
+		}
+		if !throttled {
+			return false
+		}
+	}
+	return true
+}
+
+// parseRetryAfter parses a Retry-After header, which may be either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(header)); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+// shouldRetry inspects the result of a single request and decides if it
+// should be retried. If the response body has to be read to make that
+// decision, it is replaced so that the caller may still consume it. Requests
+// that are not idempotent are only retried if they were never sent or were
+// throttled, since a transport error or server error may follow the server
+// acting on the request.
+func shouldRetry(resp *http.Response, err error, idempotent bool) (bool, time.Duration) {
+	if err != nil {
+		// Don't retry if the caller has given up
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false, 0
+		}
+		return idempotent || isNotSent(err), 0
+	}
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true, retryAfter
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return idempotent, retryAfter
+	case resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusBadRequest:
+		body, err := bufferBody(resp)
+		if err != nil {
+			return idempotent, 0
+		}
+		return isThrottlingPayload(body), retryAfter
+	}
+	return false, 0
+}
+
+// sleep waits for d, returning early with the context's error if ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testRetryQuery = `{"query":"query ReadTenant { GetTenant { name } }"}`
+
+// newTestRetryDoer returns a doer with a token that will not expire, so no
+// calls are made to Cognito.
+func newTestRetryDoer(maxRetries int) *echoStreamApiDoer {
+	token := "token"
+	return &echoStreamApiDoer{
+		accessToken: &token,
+		expiration:  time.Now().Add(time.Hour),
+		httpClient:  http.DefaultClient,
+		limiter:     newRequestLimiter(0, 0),
+		maxBackoff:  10 * time.Millisecond,
+		maxRetries:  maxRetries,
+	}
+}
+
+// newFailingServer returns a stub server that responds to the first failures
+// requests with fail, and all others with a successful GraphQL response.
+func newFailingServer(t *testing.T, failures int32, fail http.HandlerFunc) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, testRetryQuery, string(body), "request body must be resent on retry")
+		require.Equal(t, "token", r.Header.Get("Authorization"))
+		if calls.Add(1) <= failures {
+			fail(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"GetTenant":{"name":"test"}}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func doTestRequest(t *testing.T, d *echoStreamApiDoer, url string) *http.Response {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader(testRetryQuery))
+	require.NoError(t, err)
+	resp, err := d.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestDoRetriesThrottledAndServerErrors(t *testing.T) {
+	t.Parallel()
+	statuses := []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+	}
+	for _, status := range statuses {
+		server, calls := newFailingServer(t, 2, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		})
+		resp := doTestRequest(t, newTestRetryDoer(5), server.URL)
+		require.Equal(t, http.StatusOK, resp.StatusCode, "status %d", status)
+		require.Equal(t, int32(3), calls.Load(), "status %d", status)
+	}
+}
+
+func TestDoRetriesThrottlingPayload(t *testing.T) {
+	t.Parallel()
+	server, calls := newFailingServer(t, 1, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":null,"errors":[{"errorType":"ThrottlingException","message":"Rate exceeded"}]}`))
+	})
+	resp := doTestRequest(t, newTestRetryDoer(5), server.URL)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `"name":"test"`)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestDoRetriesDroppedConnection(t *testing.T) {
+	t.Parallel()
+	server, calls := newFailingServer(t, 2, func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		conn.Close()
+	})
+	resp := doTestRequest(t, newTestRetryDoer(5), server.URL)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestDoDoesNotRetryOtherErrors(t *testing.T) {
+	t.Parallel()
+	server, calls := newFailingServer(t, 1, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":null,"errors":[{"errorType":"Unauthorized","message":"Not Authorized"}]}`))
+	})
+	resp := doTestRequest(t, newTestRetryDoer(5), server.URL)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "Not Authorized", "non-retryable response body must be returned intact")
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestDoRetriesMutationsOnlyIfNotSentOrThrottled(t *testing.T) {
+	t.Parallel()
+	const mutation = `{"query":"mutation CreateTimerNode { CreateTimerNode { name } }"}`
+	doMutation := func(url string) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, strings.NewReader(mutation))
+		require.NoError(t, err)
+		resp, err := newTestRetryDoer(5).Do(req)
+		if resp != nil {
+			t.Cleanup(func() { resp.Body.Close() })
+		}
+		return resp, err
+	}
+	fails := map[string]http.HandlerFunc{
+		"server error": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		},
+		"dropped connection": func(w http.ResponseWriter, r *http.Request) {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+		},
+		"throttled": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		},
+	}
+	for name, fail := range fails {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				fail(w, r)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"CreateTimerNode":{"name":"test"}}}`))
+		}))
+		t.Cleanup(server.Close)
+		_, _ = doMutation(server.URL)
+		if name == "throttled" {
+			require.Equal(t, int32(2), calls.Load(), name)
+		} else {
+			require.Equal(t, int32(1), calls.Load(), name)
+		}
+	}
+
+	// Connections that are refused were never sent, so are retried
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	url := "http://" + listener.Addr().String()
+	listener.Close()
+	_, err = doMutation(url)
+	require.Error(t, err)
+	require.True(t, isNotSent(err))
+}
+
+func TestDoStopsAfterMaxRetries(t *testing.T) {
+	t.Parallel()
+	server, calls := newFailingServer(t, 100, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	resp := doTestRequest(t, newTestRetryDoer(3), server.URL)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, int32(4), calls.Load())
+}
+
+func TestDoHonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+	server, calls := newFailingServer(t, 1, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	d := newTestRetryDoer(5)
+	d.maxBackoff = 5 * time.Second
+	start := time.Now()
+	resp := doTestRequest(t, d, server.URL)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(2), calls.Load())
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+	for attempt := 0; attempt < 64; attempt++ {
+		wait := backoff(attempt, 0, 2*time.Second)
+		require.GreaterOrEqual(t, wait, time.Duration(0))
+		require.LessOrEqual(t, wait, 2*time.Second)
+	}
+	require.Equal(t, 2*time.Second, backoff(0, time.Minute, 2*time.Second), "Retry-After must be capped by max backoff")
+	require.Equal(t, 3*time.Second, backoff(0, 3*time.Second, time.Minute))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, 7*time.Second, parseRetryAfter("7", now))
+	require.Equal(t, 10*time.Second, parseRetryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now))
+	require.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	require.Equal(t, time.Duration(0), parseRetryAfter("garbage", now))
+}
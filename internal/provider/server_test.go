@@ -0,0 +1,661 @@
+package provider
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/test/cassette"
+	"github.com/Echo-Stream/terraform-provider-echostream/test/fake"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestProviderServer returns a provider server that is configured, as
+// Terraform would, to use a fake EchoStream API.
+func newTestProviderServer(t *testing.T) (*fake.Server, tfprotov6.ProviderServer) {
+	t.Helper()
+	server := fake.NewServer("test")
+	t.Cleanup(server.Close)
+	for key, value := range server.Env() {
+		t.Setenv(key, value)
+	}
+	return server, configureTestProviderServer(t, New("test")())
+}
+
+// configureTestProviderServer returns a provider server for p, configured as
+// Terraform would from the environment.
+func configureTestProviderServer(t *testing.T, p provider.Provider) tfprotov6.ProviderServer {
+	t.Helper()
+	providerServer, err := providerserver.NewProtocol6WithError(p)()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	requireNoDiagnostics(t, schemaResp.Diagnostics)
+	// Configure with every attribute null, so that the environment is used
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: newTestDynamicValue(t, schemaResp.Provider, nil),
+	})
+	require.NoError(t, err)
+	requireNoDiagnostics(t, configureResp.Diagnostics)
+	return providerServer
+}
+
+// newTestDynamicValue returns the value of an object with s, with values for
+// its attributes. Unset attributes are null.
+func newTestDynamicValue(t *testing.T, s *tfprotov6.Schema, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	objectType := s.ValueType().(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	require.NoError(t, err)
+	return &value
+}
+
+// decodeTestDynamicValue returns the attributes of value, an object with s.
+func decodeTestDynamicValue(t *testing.T, s *tfprotov6.Schema, value *tfprotov6.DynamicValue) map[string]tftypes.Value {
+	t.Helper()
+	require.NotNil(t, value)
+	decoded, err := value.Unmarshal(s.ValueType())
+	require.NoError(t, err)
+	var attributes map[string]tftypes.Value
+	require.NoError(t, decoded.As(&attributes))
+	return attributes
+}
+
+// newTestClient returns a client for server, for arranging test data.
+func newTestClient(t *testing.T, server *fake.Server) graphql.Client {
+	t.Helper()
+	data := newTestProviderModel()
+	data.AppsyncEndpoint = types.StringValue(server.Endpoint())
+	data.ClientId = types.StringValue(server.ClientId())
+	data.Password = types.StringValue(server.Password)
+	data.Username = types.StringValue(server.Username)
+	data.UserPoolId = types.StringValue(server.UserPoolId())
+	d, err := newEchoStreamDoer(data, "test")
+	require.NoError(t, err)
+	return graphql.NewClient(server.Endpoint(), d)
+}
+
+// openTestEphemeralResource opens the ephemeral resource typeName with
+// values for its attributes, and returns the attributes of the result.
+func openTestEphemeralResource(t *testing.T, providerServer tfprotov6.ProviderServer, typeName string, values map[string]tftypes.Value) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	s := schemaResp.EphemeralResourceSchemas[typeName]
+	require.NotNil(t, s)
+	ephemeralServer, ok := providerServer.(tfprotov6.EphemeralResourceServer)
+	require.True(t, ok)
+	openResp, err := ephemeralServer.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		Config:   newTestDynamicValue(t, s, values),
+		TypeName: typeName,
+	})
+	require.NoError(t, err)
+	if openResp.Result == nil {
+		return nil, openResp.Diagnostics
+	}
+	return decodeTestDynamicValue(t, s, openResp.Result), openResp.Diagnostics
+}
+
+// planTestResource plans to create the resource typeName with values for its
+// attributes, or to update it if priorValues is not nil, and returns the
+// diagnostics.
+func planTestResource(t *testing.T, providerServer tfprotov6.ProviderServer, typeName string, priorValues map[string]tftypes.Value, values map[string]tftypes.Value) []*tfprotov6.Diagnostic {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	s := schemaResp.ResourceSchemas[typeName]
+	require.NotNil(t, s)
+	config := newTestDynamicValue(t, s, values)
+	priorState, err := tfprotov6.NewDynamicValue(s.ValueType(), tftypes.NewValue(s.ValueType(), nil))
+	require.NoError(t, err)
+	if priorValues != nil {
+		priorState = *newTestDynamicValue(t, s, priorValues)
+	}
+	planResp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		Config:           config,
+		PriorState:       &priorState,
+		ProposedNewState: config,
+		TypeName:         typeName,
+	})
+	require.NoError(t, err)
+	return planResp.Diagnostics
+}
+
+// applyTestResource plans and applies the creation of the resource typeName
+// with values for its attributes, and returns the attributes of the new
+// state.
+func applyTestResource(t *testing.T, providerServer tfprotov6.ProviderServer, typeName string, values map[string]tftypes.Value) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	s := schemaResp.ResourceSchemas[typeName]
+	require.NotNil(t, s)
+	config := newTestDynamicValue(t, s, values)
+	priorState, err := tfprotov6.NewDynamicValue(s.ValueType(), tftypes.NewValue(s.ValueType(), nil))
+	require.NoError(t, err)
+	planResp, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		Config:           config,
+		PriorState:       &priorState,
+		ProposedNewState: config,
+		TypeName:         typeName,
+	})
+	require.NoError(t, err)
+	requireNoDiagnostics(t, planResp.Diagnostics)
+	applyResp, err := providerServer.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		Config:       config,
+		PlannedState: planResp.PlannedState,
+		PriorState:   &priorState,
+		TypeName:     typeName,
+	})
+	require.NoError(t, err)
+	if applyResp.NewState == nil {
+		return nil, applyResp.Diagnostics
+	}
+	return decodeTestDynamicValue(t, s, applyResp.NewState), applyResp.Diagnostics
+}
+
+// readTestResource refreshes the resource typeName with values for its
+// attributes, and returns the attributes of the new state.
+func readTestResource(t *testing.T, providerServer tfprotov6.ProviderServer, typeName string, values map[string]tftypes.Value) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	s := schemaResp.ResourceSchemas[typeName]
+	require.NotNil(t, s)
+	readResp, err := providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		CurrentState: newTestDynamicValue(t, s, values),
+		TypeName:     typeName,
+	})
+	require.NoError(t, err)
+	if readResp.NewState == nil {
+		return nil, readResp.Diagnostics
+	}
+	return decodeTestDynamicValue(t, s, readResp.NewState), readResp.Diagnostics
+}
+
+// readTestDataSource reads the data source typeName with values for its
+// attributes, and returns the attributes of the result.
+func readTestDataSource(t *testing.T, providerServer tfprotov6.ProviderServer, typeName string, values map[string]tftypes.Value) (map[string]tftypes.Value, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	s := schemaResp.DataSourceSchemas[typeName]
+	require.NotNil(t, s)
+	readResp, err := providerServer.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+		Config:   newTestDynamicValue(t, s, values),
+		TypeName: typeName,
+	})
+	require.NoError(t, err)
+	if readResp.State == nil {
+		return nil, readResp.Diagnostics
+	}
+	return decodeTestDynamicValue(t, s, readResp.State), readResp.Diagnostics
+}
+
+// callTestFunction calls the provider function name with arguments, and
+// returns its result.
+func callTestFunction(t *testing.T, providerServer tfprotov6.ProviderServer, name string, arguments ...tftypes.Value) (tftypes.Value, *tfprotov6.FunctionError) {
+	t.Helper()
+	ctx := context.Background()
+	functionServer, ok := providerServer.(tfprotov6.FunctionServer)
+	require.True(t, ok)
+	functionsResp, err := functionServer.GetFunctions(ctx, &tfprotov6.GetFunctionsRequest{})
+	require.NoError(t, err)
+	f := functionsResp.Functions[name]
+	require.NotNil(t, f)
+	if f.VariadicParameter == nil {
+		require.Len(t, arguments, len(f.Parameters))
+	}
+	values := []*tfprotov6.DynamicValue{}
+	for i, argument := range arguments {
+		parameter := f.VariadicParameter
+		if i < len(f.Parameters) {
+			parameter = f.Parameters[i]
+		}
+		value, err := tfprotov6.NewDynamicValue(parameter.Type, argument)
+		require.NoError(t, err)
+		values = append(values, &value)
+	}
+	callResp, err := functionServer.CallFunction(ctx, &tfprotov6.CallFunctionRequest{
+		Arguments: values,
+		Name:      name,
+	})
+	require.NoError(t, err)
+	if callResp.Error != nil {
+		return tftypes.Value{}, callResp.Error
+	}
+	result, err := callResp.Result.Unmarshal(f.Return.Type)
+	require.NoError(t, err)
+	return result, nil
+}
+
+// newTestStrings returns a value of type collection with the String elements.
+func newTestStrings(collection func(tftypes.Type) tftypes.Type, elements ...string) tftypes.Value {
+	values := []tftypes.Value{}
+	for _, element := range elements {
+		values = append(values, tftypes.NewValue(tftypes.String, element))
+	}
+	return tftypes.NewValue(collection(tftypes.String), values)
+}
+
+func testList(elementType tftypes.Type) tftypes.Type { return tftypes.List{ElementType: elementType} }
+
+func testSet(elementType tftypes.Type) tftypes.Type { return tftypes.Set{ElementType: elementType} }
+
+// testCredentialsPassword returns the password in the credentials of result.
+func testCredentialsPassword(t *testing.T, result map[string]tftypes.Value) string {
+	t.Helper()
+	var credentials map[string]tftypes.Value
+	require.NoError(t, result["credentials"].As(&credentials))
+	var password string
+	require.NoError(t, credentials["password"].As(&password))
+	return password
+}
+
+func requireNoDiagnostics(t *testing.T, diagnostics []*tfprotov6.Diagnostic) {
+	t.Helper()
+	for _, diagnostic := range diagnostics {
+		require.NotEqual(t, tfprotov6.DiagnosticSeverityError, diagnostic.Severity, "%s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+}
+
+func TestTenantAwsCredentialsEphemeralResource(t *testing.T) {
+	_, providerServer := newTestProviderServer(t)
+	ephemeralServer, ok := providerServer.(tfprotov6.EphemeralResourceServer)
+	require.True(t, ok)
+	ctx := context.Background()
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	s := schemaResp.EphemeralResourceSchemas["echostream_tenant_aws_credentials"]
+	require.NotNil(t, s)
+
+	config := newTestDynamicValue(t, s, map[string]tftypes.Value{"duration": tftypes.NewValue(tftypes.Number, 900)})
+	validateResp, err := ephemeralServer.ValidateEphemeralResourceConfig(ctx, &tfprotov6.ValidateEphemeralResourceConfigRequest{
+		Config:   config,
+		TypeName: "echostream_tenant_aws_credentials",
+	})
+	require.NoError(t, err)
+	requireNoDiagnostics(t, validateResp.Diagnostics)
+	openResp, err := ephemeralServer.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		Config:   config,
+		TypeName: "echostream_tenant_aws_credentials",
+	})
+	require.NoError(t, err)
+	requireNoDiagnostics(t, openResp.Diagnostics)
+
+	result := decodeTestDynamicValue(t, s, openResp.Result)
+	var accessKeyId, sessionToken string
+	require.NoError(t, result["access_key_id"].As(&accessKeyId))
+	require.NoError(t, result["session_token"].As(&sessionToken))
+	require.Regexp(t, "^ASIA", accessKeyId)
+	require.NotEmpty(t, sessionToken)
+
+	// The duration is validated
+	validateResp, err = ephemeralServer.ValidateEphemeralResourceConfig(ctx, &tfprotov6.ValidateEphemeralResourceConfigRequest{
+		Config:   newTestDynamicValue(t, s, map[string]tftypes.Value{"duration": tftypes.NewValue(tftypes.Number, 60)}),
+		TypeName: "echostream_tenant_aws_credentials",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, validateResp.Diagnostics)
+}
+
+func TestAppCredentialsEphemeralResource(t *testing.T) {
+	server, providerServer := newTestProviderServer(t)
+	client := newTestClient(t, server)
+	echoResp, err := api.CreateExternalApp(context.Background(), client, "external", "test", nil, nil, nil)
+	require.NoError(t, err)
+
+	result, diagnostics := openTestEphemeralResource(t, providerServer, "echostream_app_credentials", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "external"),
+	})
+	requireNoDiagnostics(t, diagnostics)
+	require.Equal(t, echoResp.CreateExternalApp.Credentials.Password, testCredentialsPassword(t, result))
+
+	// A missing App is an error
+	_, diagnostics = openTestEphemeralResource(t, providerServer, "echostream_app_credentials", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "missing"),
+	})
+	require.NotEmpty(t, diagnostics)
+}
+
+func TestApiUserCredentialsEphemeralResource(t *testing.T) {
+	server, providerServer := newTestProviderServer(t)
+	client := newTestClient(t, server)
+	echoResp, err := api.CreateApiUser(context.Background(), client, api.ApiUserRoleReadOnly, "test", nil)
+	require.NoError(t, err)
+
+	result, diagnostics := openTestEphemeralResource(t, providerServer, "echostream_api_user_credentials", map[string]tftypes.Value{
+		"username": tftypes.NewValue(tftypes.String, echoResp.CreateApiUser.Username),
+	})
+	requireNoDiagnostics(t, diagnostics)
+	require.Equal(t, echoResp.CreateApiUser.Credentials.Password, testCredentialsPassword(t, result))
+	var appsyncEndpoint string
+	require.NoError(t, result["appsync_endpoint"].As(&appsyncEndpoint))
+	require.Equal(t, echoResp.CreateApiUser.AppsyncEndpoint, appsyncEndpoint)
+}
+
+func TestNodeDataSource(t *testing.T) {
+	server, providerServer := newTestProviderServer(t)
+	client := newTestClient(t, server)
+	ctx := context.Background()
+	description := "every five minutes"
+	_, err := api.CreateTimerNode(ctx, client, "timer", "rate(5 minutes)", "test", &description)
+	require.NoError(t, err)
+	config := `{"key":"value"}`
+	loggingLevel := api.LogLevelDebug
+	_, err = api.CreateWebhookNode(ctx, client, "webhook", "test", &config, nil, nil, &loggingLevel, nil, []string{"requests"}, nil)
+	require.NoError(t, err)
+
+	result, diagnostics := readTestDataSource(t, providerServer, "echostream_node", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "timer"),
+	})
+	requireNoDiagnostics(t, diagnostics)
+	require.True(t, result["type"].Equal(tftypes.NewValue(tftypes.String, "TimerNode")))
+	require.True(t, result["description"].Equal(tftypes.NewValue(tftypes.String, description)))
+	require.True(t, result["processor_node"].IsNull())
+	var timerNode map[string]tftypes.Value
+	require.NoError(t, result["timer_node"].As(&timerNode))
+	require.True(t, timerNode["schedule_expression"].Equal(tftypes.NewValue(tftypes.String, "rate(5 minutes)")))
+	require.True(t, timerNode["send_message_type"].Equal(tftypes.NewValue(tftypes.String, "echo.timer")))
+	var nextFireTimes []tftypes.Value
+	require.NoError(t, timerNode["next_fire_times"].As(&nextFireTimes))
+	require.Len(t, nextFireTimes, 5)
+
+	result, diagnostics = readTestDataSource(t, providerServer, "echostream_node", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "webhook"),
+	})
+	requireNoDiagnostics(t, diagnostics)
+	require.True(t, result["timer_node"].IsNull())
+	var webhookNode map[string]tftypes.Value
+	require.NoError(t, result["webhook_node"].As(&webhookNode))
+	require.True(t, webhookNode["config"].Equal(tftypes.NewValue(tftypes.String, config)))
+	require.True(t, webhookNode["logging_level"].Equal(tftypes.NewValue(tftypes.String, "DEBUG")))
+	require.False(t, webhookNode["endpoint"].IsNull())
+
+	// The typed data sources reject Nodes of other types
+	result, diagnostics = readTestDataSource(t, providerServer, "echostream_timer_node", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "timer"),
+	})
+	requireNoDiagnostics(t, diagnostics)
+	require.True(t, result["schedule_expression"].Equal(tftypes.NewValue(tftypes.String, "rate(5 minutes)")))
+	_, diagnostics = readTestDataSource(t, providerServer, "echostream_timer_node", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "webhook"),
+	})
+	require.NotEmpty(t, diagnostics)
+
+	// A missing Node is an error
+	_, diagnostics = readTestDataSource(t, providerServer, "echostream_node", map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "missing"),
+	})
+	require.NotEmpty(t, diagnostics)
+}
+
+func TestBitmapFunctions(t *testing.T) {
+	_, providerServer := newTestProviderServer(t)
+	orderedFlags := newTestStrings(testList, "bit_a", "bit_b", "bit_c")
+
+	result, funcErr := callTestFunction(t, providerServer, "bitmap", newTestStrings(testSet, "bit_a", "bit_c"), orderedFlags)
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(tftypes.NewValue(tftypes.String, "0x5")))
+	result, funcErr = callTestFunction(t, providerServer, "bitmap", newTestStrings(testSet), orderedFlags)
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(tftypes.NewValue(tftypes.String, "0x0")))
+
+	// More than 64 flags
+	flags := []string{}
+	for i := 0; i < 72; i++ {
+		flags = append(flags, strconv.Itoa(i))
+	}
+	result, funcErr = callTestFunction(t, providerServer, "bitmap", newTestStrings(testSet, "0", "71"), newTestStrings(testList, flags...))
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(tftypes.NewValue(tftypes.String, "0x800000000000000001")))
+
+	result, funcErr = callTestFunction(t, providerServer, "decode_bitmap", tftypes.NewValue(tftypes.String, "0x5"), orderedFlags)
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(newTestStrings(testList, "bit_a", "bit_c")))
+	result, funcErr = callTestFunction(t, providerServer, "decode_bitmap", tftypes.NewValue(tftypes.String, "0X06"), orderedFlags)
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(newTestStrings(testList, "bit_b", "bit_c")))
+
+	// Unknown flags, duplicate ordered flags and bitmaps out of range are argument errors
+	_, funcErr = callTestFunction(t, providerServer, "bitmap", newTestStrings(testSet, "bit_d"), orderedFlags)
+	require.NotNil(t, funcErr)
+	require.Equal(t, int64(0), *funcErr.FunctionArgument)
+	_, funcErr = callTestFunction(t, providerServer, "bitmap", newTestStrings(testSet, "bit_a"), newTestStrings(testList, "bit_a", "bit_a"))
+	require.NotNil(t, funcErr)
+	require.Equal(t, int64(1), *funcErr.FunctionArgument)
+	_, funcErr = callTestFunction(t, providerServer, "decode_bitmap", tftypes.NewValue(tftypes.String, "0x8"), orderedFlags)
+	require.NotNil(t, funcErr)
+	require.Equal(t, int64(0), *funcErr.FunctionArgument)
+	for _, bitmap := range []string{"F1", "0|5"} {
+		_, funcErr = callTestFunction(t, providerServer, "decode_bitmap", tftypes.NewValue(tftypes.String, bitmap), orderedFlags)
+		require.NotNil(t, funcErr, bitmap)
+		require.Equal(t, int64(0), *funcErr.FunctionArgument)
+	}
+}
+
+func TestConfigFunctions(t *testing.T) {
+	_, providerServer := newTestProviderServer(t)
+
+	result, funcErr := callTestFunction(t, providerServer, "normalize_config", tftypes.NewValue(tftypes.String, "{\"b\": 1,\n \"a\": 2}"))
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(tftypes.NewValue(tftypes.String, `{"a":2,"b":1}`)))
+
+	result, funcErr = callTestFunction(t, providerServer, "config_size", tftypes.NewValue(tftypes.String, "{\"b\": 1,\n \"a\": 2}"))
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(tftypes.NewValue(tftypes.Number, 13)))
+
+	result, funcErr = callTestFunction(
+		t,
+		providerServer,
+		"merge_config",
+		tftypes.NewValue(tftypes.String, `{"a": {"b": 1, "c": 2}}`),
+		tftypes.NewValue(tftypes.String, `{"a": {"c": 3}}`),
+	)
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(tftypes.NewValue(tftypes.String, `{"a":{"b":1,"c":3}}`)))
+
+	// Configs that are not JSON objects and conflicts are argument errors
+	_, funcErr = callTestFunction(t, providerServer, "normalize_config", tftypes.NewValue(tftypes.String, "[]"))
+	require.NotNil(t, funcErr)
+	require.Equal(t, int64(0), *funcErr.FunctionArgument)
+	_, funcErr = callTestFunction(
+		t,
+		providerServer,
+		"merge_config",
+		tftypes.NewValue(tftypes.String, `{"a": {"b": 1}}`),
+		tftypes.NewValue(tftypes.String, `{"a": 1}`),
+	)
+	require.NotNil(t, funcErr)
+	require.Equal(t, int64(1), *funcErr.FunctionArgument)
+}
+
+func TestCronNextFunction(t *testing.T) {
+	_, providerServer := newTestProviderServer(t)
+	from := tftypes.NewValue(tftypes.String, "2024-01-30T12:00:00Z")
+
+	result, funcErr := callTestFunction(
+		t,
+		providerServer,
+		"cron_next",
+		tftypes.NewValue(tftypes.String, "cron(0 18 ? * MON-FRI *)"),
+		from,
+		tftypes.NewValue(tftypes.Number, 2),
+	)
+	require.Nil(t, funcErr)
+	require.True(t, result.Equal(newTestStrings(testList, "2024-01-30T18:00:00Z", "2024-01-31T18:00:00Z")))
+
+	// Invalid schedule expressions are argument errors
+	_, funcErr = callTestFunction(
+		t,
+		providerServer,
+		"cron_next",
+		tftypes.NewValue(tftypes.String, "cron(0 18 * * MON-FRI *)"),
+		from,
+		tftypes.NewValue(tftypes.Number, 2),
+	)
+	require.NotNil(t, funcErr)
+	require.Equal(t, int64(0), *funcErr.FunctionArgument)
+}
+
+func TestManagedNodeConfigMatchesConfigTemplate(t *testing.T) {
+	server, providerServer := newTestProviderServer(t)
+	ctx := context.Background()
+	client := newTestClient(t, server)
+	configTemplate := `{"type": "object", "properties": {"port": {"type": "integer"}}, "required": ["port"]}`
+	_, err := api.CreateManagedNodeType(ctx, client, "test", "test:latest", "test", "test", &configTemplate, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	managedNode := func(managedNodeType string, config tftypes.Value) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"app":               tftypes.NewValue(tftypes.String, "app"),
+			"config":            config,
+			"managed_node_type": tftypes.NewValue(tftypes.String, managedNodeType),
+			"name":              tftypes.NewValue(tftypes.String, "node"),
+		}
+	}
+	config := func(config string) tftypes.Value {
+		return tftypes.NewValue(tftypes.String, config)
+	}
+	noConfig := tftypes.NewValue(tftypes.String, nil)
+	existing := managedNode("test", config(`{"port": 8080}`))
+
+	// planManagedNode returns the diagnostics for the config_template
+	planManagedNode := func(priorValues map[string]tftypes.Value, values map[string]tftypes.Value) []*tfprotov6.Diagnostic {
+		diagnostics := []*tfprotov6.Diagnostic{}
+		for _, diagnostic := range planTestResource(t, providerServer, "echostream_managed_node", priorValues, values) {
+			if diagnostic.Summary == "Config does not match ManagedNodeType config_template" {
+				diagnostics = append(diagnostics, diagnostic)
+			}
+		}
+		return diagnostics
+	}
+
+	requireNoDiagnostics(t, planTestResource(t, providerServer, "echostream_managed_node", nil, managedNode("test", config(`{"port": 8080}`))))
+	// ManagedNodeTypes that do not exist yet are not validated against
+	requireNoDiagnostics(t, planTestResource(t, providerServer, "echostream_managed_node", nil, managedNode("other", config(`{"port": "8080"}`))))
+
+	// The config_template of a ManagedNodeType that this ManagedNode does not
+	// use yet may change in the same apply, so violations are warnings
+	diagnostics := planManagedNode(nil, managedNode("test", config(`{"port": "8080"}`)))
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, tfprotov6.DiagnosticSeverityWarning, diagnostics[0].Severity)
+	require.Equal(t, tftypes.NewAttributePath().WithAttributeName("config"), diagnostics[0].Attribute)
+	require.Contains(t, diagnostics[0].Detail, "#/port")
+
+	diagnostics = planManagedNode(existing, managedNode("test", config(`{"port": "8080"}`)))
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, tfprotov6.DiagnosticSeverityError, diagnostics[0].Severity)
+	require.Equal(t, tftypes.NewAttributePath().WithAttributeName("config"), diagnostics[0].Attribute)
+	require.Contains(t, diagnostics[0].Detail, "#/port")
+	require.Len(t, planManagedNode(existing, managedNode("test", config(`{}`))), 1)
+
+	// A ManagedNode without a config is validated as an empty config
+	diagnostics = planManagedNode(existing, managedNode("test", noConfig))
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, tfprotov6.DiagnosticSeverityError, diagnostics[0].Severity)
+	diagnostics = planManagedNode(nil, managedNode("test", noConfig))
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, tfprotov6.DiagnosticSeverityWarning, diagnostics[0].Severity)
+}
+
+func TestTenantResourceReplaysScrubbedConfig(t *testing.T) {
+	server := fake.NewServer("test")
+	t.Cleanup(server.Close)
+	for key, value := range server.Env() {
+		t.Setenv(key, value)
+	}
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	tenant := map[string]tftypes.Value{
+		"config":      tftypes.NewValue(tftypes.String, `{"password": "s3cret", "ports": [80, 443]}`),
+		"description": tftypes.NewValue(tftypes.String, "tenant"),
+	}
+
+	recorder, err := cassette.NewRecorder(path, cassette.ModeRecord)
+	require.NoError(t, err)
+	providerServer := configureTestProviderServer(t, NewWithDoerWrapper("test", recorder.Wrap)())
+	state, diagnostics := applyTestResource(t, providerServer, "echostream_tenant", tenant)
+	requireNoDiagnostics(t, diagnostics)
+	_, diagnostics = readTestResource(t, providerServer, "echostream_tenant", state)
+	requireNoDiagnostics(t, diagnostics)
+	require.NoError(t, recorder.Save())
+
+	// Replayed configs are scrubbed, but are still valid configs
+	server.Close()
+	recorder, err = cassette.NewRecorder(path, cassette.ModeReplay)
+	require.NoError(t, err)
+	providerServer = configureTestProviderServer(t, NewWithDoerWrapper("test", recorder.Wrap)())
+	scrubbed := tftypes.NewValue(tftypes.String, `{"password":"`+cassette.Scrubbed+`","ports":[80,443]}`)
+	state, diagnostics = applyTestResource(t, providerServer, "echostream_tenant", tenant)
+	requireNoDiagnostics(t, diagnostics)
+	require.True(t, scrubbed.Equal(state["config"]), state["config"].String())
+	state, diagnostics = readTestResource(t, providerServer, "echostream_tenant", state)
+	requireNoDiagnostics(t, diagnostics)
+	require.True(t, scrubbed.Equal(state["config"]), state["config"].String())
+}
+
+func TestNodesDataSourceInvalidNameRegex(t *testing.T) {
+	_, providerServer := newTestProviderServer(t)
+
+	// Reading does not validate name_regex again, as it may have been unknown
+	// when the config was validated
+	_, diagnostics := readTestDataSource(t, providerServer, "echostream_nodes", map[string]tftypes.Value{
+		"name_regex": tftypes.NewValue(tftypes.String, "("),
+	})
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, tfprotov6.DiagnosticSeverityError, diagnostics[0].Severity)
+	require.Equal(t, tftypes.NewAttributePath().WithAttributeName("name_regex"), diagnostics[0].Attribute)
+
+	result, diagnostics := readTestDataSource(t, providerServer, "echostream_nodes", map[string]tftypes.Value{
+		"name_regex": tftypes.NewValue(tftypes.String, "^Log "),
+	})
+	requireNoDiagnostics(t, diagnostics)
+	var nodes []tftypes.Value
+	require.NoError(t, result["nodes"].As(&nodes))
+	require.NotEmpty(t, nodes)
+}
+
+func TestManagedNodeTypeConfigTemplateDiff(t *testing.T) {
+	_, providerServer := newTestProviderServer(t)
+	managedNodeType := func(configTemplate string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"config_template": tftypes.NewValue(tftypes.String, configTemplate),
+			"description":     tftypes.NewValue(tftypes.String, "test"),
+			"image_uri":       tftypes.NewValue(tftypes.String, "test:latest"),
+			"name":            tftypes.NewValue(tftypes.String, "test"),
+		}
+	}
+	existing := managedNodeType(`{"type": "object", "required": ["port"]}`)
+
+	diagnostics := planTestResource(t, providerServer, "echostream_managed_node_type", existing, managedNodeType(`{"type": "object", "required": ["host"]}`))
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, "Config keys will change", diagnostics[0].Summary)
+	require.Equal(t, tftypes.NewAttributePath().WithAttributeName("config_template"), diagnostics[0].Attribute)
+	require.Contains(t, diagnostics[0].Detail, "Changed:\n  /required")
+
+	// Changing only the key order or whitespace is not a change
+	requireNoDiagnostics(t, planTestResource(t, providerServer, "echostream_managed_node_type", existing, managedNodeType(`{"required":["port"],"type":"object"}`)))
+}
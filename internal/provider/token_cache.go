@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cachedToken is the authentication state persisted for a single ApiUser.
+type cachedToken struct {
+	AccessToken  string    `json:"access_token"`
+	Expiration   time.Time `json:"expiration"`
+	RefreshToken string    `json:"refresh_token"`
+}
+
+// tokenCache persists Cognito tokens across provider processes so that the
+// SRP handshake need not be repeated for every Terraform command. Entries are
+// keyed by user pool, client id and username.
+type tokenCache struct {
+	key  string
+	path string
+}
+
+func newTokenCache(path string, userPoolId string, clientId string, username string) *tokenCache {
+	return &tokenCache{
+		key:  strings.Join([]string{userPoolId, clientId, username}, "|"),
+		path: path,
+	}
+}
+
+func (c *tokenCache) readAll() (map[string]cachedToken, error) {
+	entries := map[string]cachedToken{}
+	content, err := os.ReadFile(c.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return entries, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// load returns the cached token, or nil if there is none.
+func (c *tokenCache) load() (*cachedToken, error) {
+	entries, err := c.readAll()
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := entries[c.key]; ok {
+		return &token, nil
+	}
+	return nil, nil
+}
+
+// store saves token to the cache. The cache file is written atomically with
+// 0600 permissions, as it contains secrets.
+func (c *tokenCache) store(token cachedToken) error {
+	entries, err := c.readAll()
+	if err != nil {
+		// A corrupt cache is replaced rather than preventing authentication
+		entries = map[string]cachedToken{}
+	}
+	entries[c.key] = token
+	content, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(c.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(dir, filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	if err := temp.Chmod(0600); err != nil {
+		temp.Close()
+		return err
+	}
+	if _, err := temp.Write(content); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), c.path)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenCache(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "cache", "token_cache.json")
+	dev := newTokenCache(path, "us-east-1_pool", "client", "dev")
+	prod := newTokenCache(path, "us-east-1_pool", "client", "prod")
+
+	token, err := dev.load()
+	require.NoError(t, err)
+	require.Nil(t, token, "missing cache file is a cache miss")
+
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	require.NoError(t, dev.store(cachedToken{AccessToken: "dev-access", Expiration: expiration, RefreshToken: "dev-refresh"}))
+	require.NoError(t, prod.store(cachedToken{AccessToken: "prod-access", Expiration: expiration, RefreshToken: "prod-refresh"}))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	token, err = dev.load()
+	require.NoError(t, err)
+	require.Equal(t, &cachedToken{AccessToken: "dev-access", Expiration: expiration, RefreshToken: "dev-refresh"}, token)
+
+	token, err = prod.load()
+	require.NoError(t, err)
+	require.Equal(t, "prod-refresh", token.RefreshToken)
+
+	// Temporary files are not left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestTokenCacheReplacesCorruptFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "token_cache.json")
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0600))
+	cache := newTokenCache(path, "us-east-1_pool", "client", "dev")
+
+	_, err := cache.load()
+	require.Error(t, err)
+
+	require.NoError(t, cache.store(cachedToken{AccessToken: "access", RefreshToken: "refresh"}))
+	token, err := cache.load()
+	require.NoError(t, err)
+	require.Equal(t, "refresh", token.RefreshToken)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotpCode(t *testing.T) {
+	t.Parallel()
+	// RFC 6238 SHA1 test vectors, truncated to 6 digits
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, expected := range vectors {
+		code, err := totpCode(secret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		require.Equal(t, expected, code, "time %d", unix)
+	}
+
+	// Secrets are often displayed lowercase and grouped
+	code, err := totpCode("gezd gnbv gy3t qojq gezd gnbv gy3t qojq", time.Unix(59, 0))
+	require.NoError(t, err)
+	require.Equal(t, "287082", code)
+
+	_, err = totpCode("not base32!", time.Now())
+	require.Error(t, err)
+}
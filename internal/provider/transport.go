@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultRequestTimeout = 60 * time.Second
+
+// EchoStreamHttpModel describes the provider http block data model.
+type EchoStreamHttpModel struct {
+	CaBundleFile       types.String `tfsdk:"ca_bundle_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyUrl           types.String `tfsdk:"proxy_url"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+}
+
+// userAgentTransport identifies the provider in the User-Agent of every
+// request. If a User-Agent is already set (e.g. - by the AWS SDK), the
+// provider's is prepended to it.
+type userAgentTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if ua := req.Header.Get("User-Agent"); ua != "" {
+		req.Header.Set("User-Agent", t.userAgent+" "+ua)
+	} else {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// newHttpClient returns the http.Client used for all requests made by the
+// provider, both to the EchoStream API and to AWS Cognito.
+func newHttpClient(data *EchoStreamHttpModel, version string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	timeout := defaultRequestTimeout
+
+	if data != nil {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if !(data.CaBundleFile.IsNull() || data.CaBundleFile.IsUnknown()) {
+			pem, err := os.ReadFile(data.CaBundleFile.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("unable to read ca_bundle_file: %w", err)
+			}
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, errors.New("ca_bundle_file does not contain any PEM encoded certificates")
+			}
+			tlsConfig.RootCAs = pool
+		}
+		if !(data.InsecureSkipVerify.IsNull() || data.InsecureSkipVerify.IsUnknown()) {
+			tlsConfig.InsecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+		}
+		transport.TLSClientConfig = tlsConfig
+		if !(data.ProxyUrl.IsNull() || data.ProxyUrl.IsUnknown()) {
+			proxyUrl, err := url.Parse(data.ProxyUrl.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxy_url: %w", err)
+			}
+			transport.Proxy = http.ProxyURL(proxyUrl)
+		}
+		if !(data.RequestTimeout.IsNull() || data.RequestTimeout.IsUnknown()) {
+			var err error
+			if timeout, err = time.ParseDuration(data.RequestTimeout.ValueString()); err != nil {
+				return nil, fmt.Errorf("invalid request_timeout: %w", err)
+			}
+		}
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &userAgentTransport{
+			base:      transport,
+			userAgent: "terraform-provider-echostream/" + version,
+		},
+	}, nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHttpModel() *EchoStreamHttpModel {
+	return &EchoStreamHttpModel{
+		CaBundleFile:       types.StringNull(),
+		InsecureSkipVerify: types.BoolNull(),
+		ProxyUrl:           types.StringNull(),
+		RequestTimeout:     types.StringNull(),
+	}
+}
+
+func TestHttpClientUserAgent(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("User-Agent")))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := newHttpClient(nil, "1.2.3")
+	require.NoError(t, err)
+	require.Equal(t, defaultRequestTimeout, client.Timeout)
+
+	getUserAgent := func(userAgent string) string {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		if userAgent != "" {
+			req.Header.Set("User-Agent", userAgent)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	require.Equal(t, "terraform-provider-echostream/1.2.3", getUserAgent(""))
+	require.Equal(t, "terraform-provider-echostream/1.2.3 aws-sdk-go-v2/1.0", getUserAgent("aws-sdk-go-v2/1.0"))
+}
+
+func TestHttpClientCaBundle(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	// Untrusted without the CA bundle
+	client, err := newHttpClient(newTestHttpModel(), "test")
+	require.NoError(t, err)
+	_, err = client.Get(server.URL)
+	require.Error(t, err)
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(
+		caBundle,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+		0600,
+	))
+	data := newTestHttpModel()
+	data.CaBundleFile = types.StringValue(caBundle)
+	client, err = newHttpClient(data, "test")
+	require.NoError(t, err)
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	data = newTestHttpModel()
+	data.InsecureSkipVerify = types.BoolValue(true)
+	client, err = newHttpClient(data, "test")
+	require.NoError(t, err)
+	resp, err = client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	data = newTestHttpModel()
+	data.CaBundleFile = types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))
+	_, err = newHttpClient(data, "test")
+	require.Error(t, err)
+}
+
+func TestHttpClientProxyAndTimeout(t *testing.T) {
+	t.Parallel()
+	proxied := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- r.URL.String()
+	}))
+	t.Cleanup(proxy.Close)
+
+	data := newTestHttpModel()
+	data.ProxyUrl = types.StringValue(proxy.URL)
+	data.RequestTimeout = types.StringValue("5s")
+	client, err := newHttpClient(data, "test")
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, client.Timeout)
+
+	resp, err := client.Get("http://api.example.com/graphql")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, "http://api.example.com/graphql", <-proxied)
+}
@@ -0,0 +1,157 @@
+package tenant
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+)
+
+type graphApp struct {
+	Description *string
+	Name        string
+	Type        string
+}
+
+type graphEdge struct {
+	MessageType string
+	Source      string
+	Target      string
+}
+
+// graph is the Apps, Nodes and Edges of a Tenant, sorted so that it always
+// renders the same way.
+type graph struct {
+	apps  []*graphApp
+	edges []*graphEdge
+	nodes []*common.ListedNode
+}
+
+func newGraph(apps []*graphApp, edges []*graphEdge, nodes []*common.ListedNode) *graph {
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Source != edges[j].Source {
+			return edges[i].Source < edges[j].Source
+		}
+		return edges[i].Target < edges[j].Target
+	})
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return &graph{apps: apps, edges: edges, nodes: nodes}
+}
+
+// groups returns the names of the Apps that have Nodes, in order, and the
+// Nodes of each. Nodes that are not part of an App are under "".
+func (g *graph) groups() ([]string, map[string][]*common.ListedNode) {
+	members := map[string][]*common.ListedNode{}
+	for _, node := range g.nodes {
+		app := ""
+		if node.App != nil {
+			app = *node.App
+		}
+		members[app] = append(members[app], node)
+	}
+	var apps []string
+	for app := range members {
+		if app != "" {
+			apps = append(apps, app)
+		}
+	}
+	sort.Strings(apps)
+	return apps, members
+}
+
+// dot renders the graph in Graphviz DOT format. Apps are clusters and Edges
+// are labeled with their MessageType.
+func (g *graph) dot(name string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(name))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	apps, members := g.groups()
+	for i, app := range apps {
+		fmt.Fprintf(&b, "  subgraph cluster_%d {\n", i)
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(app))
+		for _, node := range members[app] {
+			fmt.Fprintf(&b, "    %s [label=%s];\n", dotQuote(node.Name), dotNodeLabel(node))
+		}
+		b.WriteString("  }\n")
+	}
+	for _, node := range members[""] {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", dotQuote(node.Name), dotNodeLabel(node))
+	}
+	for _, edge := range g.edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(edge.Source), dotQuote(edge.Target), dotQuote(edge.MessageType))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// mermaid renders the graph as a Mermaid flowchart. Apps are subgraphs and
+// Edges are labeled with their MessageType.
+func (g *graph) mermaid() string {
+	// Mermaid ids cannot contain most characters that names can, so Nodes
+	// are identified by their position.
+	ids := make(map[string]string, len(g.nodes))
+	for i, node := range g.nodes {
+		ids[node.Name] = fmt.Sprintf("n%d", i)
+	}
+	id := func(name string) string {
+		if id, ok := ids[name]; ok {
+			return id
+		}
+		// An Edge to a Node that was not listed, which can only happen if
+		// the Tenant changed while it was being read
+		ids[name] = fmt.Sprintf("n%d", len(ids))
+		return ids[name]
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	apps, members := g.groups()
+	for i, app := range apps {
+		fmt.Fprintf(&b, "  subgraph app%d [%s]\n", i, mermaidQuote(app))
+		for _, node := range members[app] {
+			fmt.Fprintf(&b, "    %s[%s]\n", id(node.Name), mermaidNodeLabel(node))
+		}
+		b.WriteString("  end\n")
+	}
+	for _, node := range members[""] {
+		fmt.Fprintf(&b, "  %s[%s]\n", id(node.Name), mermaidNodeLabel(node))
+	}
+	for _, edge := range g.edges {
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", id(edge.Source), mermaidQuote(edge.MessageType), id(edge.Target))
+	}
+	return b.String()
+}
+
+func dotNodeLabel(node *common.ListedNode) string {
+	return `"` + dotEscape(node.Name) + `\n` + dotEscape(node.Type) + `"`
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func dotQuote(s string) string {
+	return `"` + dotEscape(s) + `"`
+}
+
+func mermaidNodeLabel(node *common.ListedNode) string {
+	return `"` + mermaidEscape(node.Name) + "<br/>" + mermaidEscape(node.Type) + `"`
+}
+
+// mermaidEscape escapes s for use in a quoted Mermaid label, which accepts
+// HTML entities.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(
+		`"`, "#quot;",
+		"<", "#lt;",
+		">", "#gt;",
+		"\n", " ",
+	).Replace(s)
+}
+
+func mermaidQuote(s string) string {
+	return `"` + mermaidEscape(s) + `"`
+}
@@ -0,0 +1,50 @@
+package tenant
+
+import (
+	"testing"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+func testGraph() *graph {
+	app := "inbound"
+	text := "echo.text"
+	return newGraph(
+		[]*graphApp{{Name: app, Type: "ExternalApp"}},
+		[]*graphEdge{
+			{MessageType: text, Source: `say "hi"`, Target: "receiver"},
+			{MessageType: text, Source: "receiver", Target: `say "hi"`},
+		},
+		[]*common.ListedNode{
+			{Name: `say "hi"`, ReceiveMessageType: &text, SendMessageType: &text, Type: "ProcessorNode"},
+			{App: &app, Name: "receiver", SendMessageType: &text, Type: "ExternalNode"},
+		},
+	)
+}
+
+func TestGraphDot(t *testing.T) {
+	require.Equal(t, `digraph "tenant" {
+  rankdir=LR;
+  node [shape=box];
+  subgraph cluster_0 {
+    label="inbound";
+    "receiver" [label="receiver\nExternalNode"];
+  }
+  "say \"hi\"" [label="say \"hi\"\nProcessorNode"];
+  "receiver" -> "say \"hi\"" [label="echo.text"];
+  "say \"hi\"" -> "receiver" [label="echo.text"];
+}
+`, testGraph().dot("tenant"))
+}
+
+func TestGraphMermaid(t *testing.T) {
+	require.Equal(t, `flowchart LR
+  subgraph app0 ["inbound"]
+    n0["receiver<br/>ExternalNode"]
+  end
+  n1["say #quot;hi#quot;<br/>ProcessorNode"]
+  n0 -->|"echo.text"| n1
+  n1 -->|"echo.text"| n0
+`, testGraph().mermaid())
+}
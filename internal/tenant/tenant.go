@@ -32,7 +32,7 @@ func readTenantData(ctx context.Context, client graphql.Client, tenant string, d
 	)
 
 	if echoResp, err = api.ReadTenant(ctx, client, tenant); err != nil {
-		diags.AddError("Error reading Tenant data", err.Error())
+		diags.Append(common.ApiErrorDiagnostic("Error reading Tenant data", err))
 	} else if echoResp.GetTenant == nil {
 		diags.AddError("Tenant not found", fmt.Sprintf("Unable to find Tenant '%s'", tenant))
 	} else {
@@ -92,7 +92,7 @@ func readTenantAwsCredentials(ctx context.Context, client graphql.Client, tenant
 	}
 
 	if err != nil {
-		diags.AddError("Error reading Tenant AWS Credentials", err.Error())
+		diags.Append(common.ApiErrorDiagnostic("Error reading Tenant AWS Credentials", err))
 	}
 	return diags
 }
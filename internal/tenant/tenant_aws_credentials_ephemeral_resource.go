@@ -0,0 +1,106 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/maps"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResourceWithConfigure = &TenantAwsCredentialsEphemeralResource{}
+
+type TenantAwsCredentialsEphemeralResource struct {
+	data *common.ProviderData
+}
+
+type tenantAwsCredentialsModel struct {
+	AccessKeyId     types.String `tfsdk:"access_key_id"`
+	Duration        types.Int64  `tfsdk:"duration"`
+	Expiration      types.String `tfsdk:"expiration"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	SessionToken    types.String `tfsdk:"session_token"`
+}
+
+func (r *TenantAwsCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *TenantAwsCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_aws_credentials"
+}
+
+func (r *TenantAwsCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config tenantAwsCredentialsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var duration *int
+	if !config.Duration.IsNull() {
+		d := int(config.Duration.ValueInt64())
+		duration = &d
+	}
+
+	if echoResp, err := api.ReadTenantAwsCredentials(ctx, r.data.Client, r.data.Tenant, duration); err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading Tenant AWS Credentials", err))
+		return
+	} else if echoResp.GetTenant == nil {
+		resp.Diagnostics.AddError("Tenant not found", fmt.Sprintf("Unable to find Tenant '%s'", r.data.Tenant))
+		return
+	} else {
+		credentials := echoResp.GetTenant.GetAwsCredentials
+		config.AccessKeyId = types.StringValue(credentials.AccessKeyId)
+		config.Expiration = types.StringValue(credentials.Expiration)
+		config.SecretAccessKey = types.StringValue(credentials.SecretAccessKey)
+		config.SessionToken = types.StringValue(credentials.SessionToken)
+	}
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func (r *TenantAwsCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := common.AwsCredentialsEphemeralResourceSchema()
+	maps.Copy(
+		attributes,
+		map[string]schema.Attribute{
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The duration of the session, in seconds, between `900` and `43200`. If not set, the EchoStream default is used.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.Between(900, 43200)},
+			},
+		},
+	)
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		MarkdownDescription: "Short-lived AWS Session Credentials that allow the current ApiUser (configured in the provider) " +
+			"to access the Tenant's resources. As an ephemeral resource, they are never stored in the plan or state.",
+	}
+}
@@ -0,0 +1,267 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSourceWithConfigure = &TenantGraphDataSource{}
+
+type TenantGraphDataSource struct {
+	data *common.ProviderData
+}
+
+type tenantGraphModel struct {
+	Apps    types.List   `tfsdk:"apps"`
+	Dot     types.String `tfsdk:"dot"`
+	Edges   types.List   `tfsdk:"edges"`
+	Mermaid types.String `tfsdk:"mermaid"`
+	Nodes   types.List   `tfsdk:"nodes"`
+}
+
+func graphAppAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"description": types.StringType,
+		"name":        types.StringType,
+		"type":        types.StringType,
+	}
+}
+
+func graphEdgeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"message_type": types.StringType,
+		"source":       types.StringType,
+		"target":       types.StringType,
+	}
+}
+
+func graphNodeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"app":                  types.StringType,
+		"name":                 types.StringType,
+		"receive_message_type": types.StringType,
+		"send_message_type":    types.StringType,
+		"type":                 types.StringType,
+	}
+}
+
+// lists returns the structured lists of the Apps, Edges and Nodes in g.
+func (g *graph) lists() (apps types.List, edges types.List, nodes types.List, diags diag.Diagnostics) {
+	var elems []attr.Value
+	for _, app := range g.apps {
+		elem, d := types.ObjectValue(graphAppAttrTypes(), map[string]attr.Value{
+			"description": types.StringPointerValue(app.Description),
+			"name":        types.StringValue(app.Name),
+			"type":        types.StringValue(app.Type),
+		})
+		diags.Append(d...)
+		elems = append(elems, elem)
+	}
+	apps, d := types.ListValue(types.ObjectType{AttrTypes: graphAppAttrTypes()}, elems)
+	diags.Append(d...)
+
+	elems = nil
+	for _, edge := range g.edges {
+		elem, d := types.ObjectValue(graphEdgeAttrTypes(), map[string]attr.Value{
+			"message_type": types.StringValue(edge.MessageType),
+			"source":       types.StringValue(edge.Source),
+			"target":       types.StringValue(edge.Target),
+		})
+		diags.Append(d...)
+		elems = append(elems, elem)
+	}
+	edges, d = types.ListValue(types.ObjectType{AttrTypes: graphEdgeAttrTypes()}, elems)
+	diags.Append(d...)
+
+	elems = nil
+	for _, node := range g.nodes {
+		elem, d := types.ObjectValue(graphNodeAttrTypes(), map[string]attr.Value{
+			"app":                  types.StringPointerValue(node.App),
+			"name":                 types.StringValue(node.Name),
+			"receive_message_type": types.StringPointerValue(node.ReceiveMessageType),
+			"send_message_type":    types.StringPointerValue(node.SendMessageType),
+			"type":                 types.StringValue(node.Type),
+		})
+		diags.Append(d...)
+		elems = append(elems, elem)
+	}
+	nodes, d = types.ListValue(types.ObjectType{AttrTypes: graphNodeAttrTypes()}, elems)
+	diags.Append(d...)
+
+	return apps, edges, nodes, diags
+}
+
+func (d *TenantGraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *TenantGraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_graph"
+}
+
+func (d *TenantGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config tenantGraphModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listedApps, err := common.ListApps(ctx, d.data)
+	if err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error listing Apps", err))
+		return
+	}
+	listedEdges, err := common.ListEdges(ctx, d.data)
+	if err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error listing Edges", err))
+		return
+	}
+	nodes, err := common.ListNodes(ctx, d.data)
+	if err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error listing Nodes", err))
+		return
+	}
+
+	apps := make([]*graphApp, 0, len(listedApps))
+	for _, app := range listedApps {
+		a := &graphApp{Description: app.GetDescription(), Name: app.GetName()}
+		if app.GetTypename() != nil {
+			a.Type = *app.GetTypename()
+		}
+		apps = append(apps, a)
+	}
+	edges := make([]*graphEdge, 0, len(listedEdges))
+	for _, edge := range listedEdges {
+		edges = append(edges, &graphEdge{
+			MessageType: edge.MessageType.Name,
+			Source:      edge.Source.GetName(),
+			Target:      edge.Target.GetName(),
+		})
+	}
+	g := newGraph(apps, edges, nodes)
+
+	var diags diag.Diagnostics
+	config.Apps, config.Edges, config.Nodes, diags = g.lists()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Dot = types.StringValue(g.dot(d.data.Tenant))
+	config.Mermaid = types.StringValue(g.mermaid())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *TenantGraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"apps": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Apps in the Tenant, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A human-readable description.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the App.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the App (e.g. - `ManagedApp`).",
+						},
+					},
+				},
+			},
+			"dot": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The graph in [Graphviz DOT](https://graphviz.org/doc/info/lang.html) format. " +
+					"Nodes are clustered by App and Edges are labeled with their MessageType.",
+			},
+			"edges": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Edges in the Tenant, sorted by source and target.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"message_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The MessageType that is transmitted.",
+						},
+						"source": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The source Node that messages are transmitted from.",
+						},
+						"target": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The target Node that messages are transmitted to.",
+						},
+					},
+				},
+			},
+			"mermaid": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The graph as a [Mermaid](https://mermaid.js.org/syntax/flowchart.html) flowchart. " +
+					"Nodes are grouped in subgraphs by App and Edges are labeled with their MessageType.",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The Nodes in the Tenant, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"app": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The App this Node is associated with, if any.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the Node.",
+						},
+						"receive_message_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The MessageType that this Node is capable of receiving, if any.",
+						},
+						"send_message_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The MessageType that this Node is capable of sending, if any.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the Node (e.g. - `ProcessorNode`).",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Gets the processing graph of the current Tenant: its Apps, Nodes and Edges, " +
+			"and renderings of them in Graphviz DOT and Mermaid formats.",
+	}
+}
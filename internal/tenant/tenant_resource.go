@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -53,7 +54,7 @@ func (r *TenantResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(r.createOrUpdate(ctx, &plan)...)
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, &plan, common.AttributeNames(req.Plan.Raw))...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -74,7 +75,7 @@ func (r *TenantResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	if _, err := api.UpdateTenant(ctx, r.data.Client, r.data.Tenant, nil, nil, nil); err != nil {
-		resp.Diagnostics.AddError("Error deleting Tenant", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting Tenant", err))
 		return
 	}
 }
@@ -149,6 +150,7 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				CustomType:          common.ConfigType{},
 				MarkdownDescription: "The config for the Tenant. All nodes in the Tenant will be allowed to access this. Must be a JSON object.",
 				Optional:            true,
+				PlanModifiers:       []planmodifier.String{common.ConfigDiffPlanModifier},
 				Sensitive:           true,
 			},
 			"description": schema.StringAttribute{
@@ -185,7 +187,7 @@ func (r *TenantResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(r.createOrUpdate(ctx, &plan)...)
+	resp.Diagnostics.Append(r.createOrUpdate(ctx, &plan, common.AttributeNames(req.Plan.Raw))...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -195,7 +197,7 @@ func (r *TenantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *TenantResource) createOrUpdate(ctx context.Context, data *tenantModel) diag.Diagnostics {
+func (r *TenantResource) createOrUpdate(ctx context.Context, data *tenantModel, attributes []string) diag.Diagnostics {
 	var (
 		audit       *bool
 		config      *string
@@ -217,10 +219,7 @@ func (r *TenantResource) createOrUpdate(ctx context.Context, data *tenantModel)
 	}
 
 	if echoResp, err := api.UpdateTenant(ctx, r.data.Client, r.data.Tenant, audit, config, description); err != nil {
-		diags.AddError(
-			"Unexpected error creating or updating Tenant",
-			fmt.Sprintf("This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error()),
-		)
+		diags.Append(common.ApiErrorDiagnostic("Error creating or updating Tenant", err, attributes...))
 	} else if echoResp == nil {
 		diags.AddError(
 			"Unexpected error creating or updating Tenant",
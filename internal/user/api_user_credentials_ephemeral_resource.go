@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ ephemeral.EphemeralResourceWithConfigure = &ApiUserCredentialsEphemeralResource{}
+
+type ApiUserCredentialsEphemeralResource struct {
+	data *common.ProviderData
+}
+
+type apiUserCredentialsModel struct {
+	AppsyncEndpoint types.String `tfsdk:"appsync_endpoint"`
+	Credentials     types.Object `tfsdk:"credentials"`
+	Username        types.String `tfsdk:"username"`
+}
+
+func (r *ApiUserCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *ApiUserCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_user_credentials"
+}
+
+func (r *ApiUserCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config apiUserCredentialsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	echoResp, err := api.ReadApiUser(ctx, r.data.Client, r.data.Tenant, config.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ApiUser", err))
+		return
+	} else if echoResp.GetApiUser == nil {
+		resp.Diagnostics.AddError("ApiUser not found", fmt.Sprintf("Unable to find ApiUser '%s'", config.Username.ValueString()))
+		return
+	}
+
+	config.AppsyncEndpoint = types.StringValue(echoResp.GetApiUser.AppsyncEndpoint)
+	credentials, diags := common.CognitoCredentialsValue(types.BoolValue(true), &echoResp.GetApiUser.Credentials.CognitoCredentialsFields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Credentials = credentials
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func (r *ApiUserCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"appsync_endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The EchoStream AppSync Endpoint that this ApiUser must use.",
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Attributes:          common.CognitoCredentialsEphemeralResourceSchema(),
+				Computed:            true,
+				MarkdownDescription: "The AWS Cognito Credentials assigned to this ApiUser that must be used when accessing the appsync_endpoint.",
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The ApiUser's username.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "The AWS Cognito Credentials of an ApiUser. As an ephemeral resource, they are never stored in the plan or state.",
+	}
+}
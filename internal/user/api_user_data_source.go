@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSourceWithConfigure = &ApiUserDataSource{}
+
+type ApiUserDataSource struct {
+	data *common.ProviderData
+}
+
+type apiUserDataSourceModel struct {
+	AppsyncEndpoint types.String `tfsdk:"appsync_endpoint"`
+	Description     types.String `tfsdk:"description"`
+	Role            types.String `tfsdk:"role"`
+	Username        types.String `tfsdk:"username"`
+}
+
+func (d *ApiUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *ApiUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_user"
+}
+
+func (d *ApiUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config apiUserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if echoResp, err := api.ReadApiUser(ctx, d.data.Client, d.data.Tenant, config.Username.ValueString()); err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ApiUser", err))
+		return
+	} else if echoResp.GetApiUser == nil {
+		resp.Diagnostics.AddError("ApiUser not found", fmt.Sprintf("Unable to find ApiUser '%s'", config.Username.ValueString()))
+		return
+	} else {
+		config.AppsyncEndpoint = types.StringValue(echoResp.GetApiUser.AppsyncEndpoint)
+		config.Description = types.StringPointerValue(echoResp.GetApiUser.Description)
+		config.Role = types.StringValue(string(echoResp.GetApiUser.Role))
+		config.Username = types.StringValue(echoResp.GetApiUser.Username)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *ApiUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"appsync_endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The EchoStream AppSync Endpoint that this ApiUser must use.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Human-readble description for this ApiUser.",
+			},
+			"role": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The ApiUser's role. One of `%s`, `%s`, or `%s`.", api.ApiUserRoleAdmin, api.ApiUserRoleReadOnly, api.ApiUserRoleUser),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The ApiUser's username.",
+				Required:            true,
+			},
+		},
+		MarkdownDescription: "A specific ApiUser in the Tenant. Its credentials may be obtained with the " +
+			"`echostream_api_user_credentials` ephemeral resource.",
+	}
+}
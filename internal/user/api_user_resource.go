@@ -3,10 +3,10 @@ package user
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,11 +26,13 @@ type ApiUserResource struct {
 }
 
 type apiUserModel struct {
-	AppsyncEndpoint types.String `tfsdk:"appsync_endpoint"`
-	Credentials     types.Object `tfsdk:"credentials"`
-	Description     types.String `tfsdk:"description"`
-	Role            types.String `tfsdk:"role"`
-	Username        types.String `tfsdk:"username"`
+	AppsyncEndpoint  types.String   `tfsdk:"appsync_endpoint"`
+	Credentials      types.Object   `tfsdk:"credentials"`
+	Description      types.String   `tfsdk:"description"`
+	Role             types.String   `tfsdk:"role"`
+	StoreCredentials types.Bool     `tfsdk:"store_credentials"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	Username         types.String   `tfsdk:"username"`
 }
 
 func (r *ApiUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -80,20 +82,12 @@ func (r *ApiUserResource) Create(ctx context.Context, req resource.CreateRequest
 		description,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating ApiUser", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating ApiUser", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
 	plan.AppsyncEndpoint = types.StringValue(echoResp.CreateApiUser.AppsyncEndpoint)
-	plan.Credentials, diags = types.ObjectValue(
-		common.CognitoCredentialsAttrTypes(),
-		common.CognitoCredentialsAttrValues(
-			echoResp.CreateApiUser.Credentials.ClientId,
-			echoResp.CreateApiUser.Credentials.Password,
-			echoResp.CreateApiUser.Credentials.UserPoolId,
-			echoResp.CreateApiUser.Credentials.Username,
-		),
-	)
+	plan.Credentials, diags = common.CognitoCredentialsValue(plan.StoreCredentials, &echoResp.CreateApiUser.Credentials.CognitoCredentialsFields)
 	if diags != nil && diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 	}
@@ -119,12 +113,24 @@ func (r *ApiUserResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteApiUser(ctx, r.data.Client, r.data.Tenant, state.Username.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Error deleting ApiUser", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting ApiUser", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, apiUserExists(r.data, state.Username.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for ApiUser to be deleted", err.Error())
+	}
 }
 
 func (r *ApiUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -148,23 +154,24 @@ func (r *ApiUserResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// Imported resources have no configuration yet, so use the default
+	if state.StoreCredentials.IsNull() {
+		state.StoreCredentials = types.BoolValue(true)
+	}
+
 	if echoResp, err := api.ReadApiUser(ctx, r.data.Client, r.data.Tenant, state.Username.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Error reading ApiUser", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading ApiUser", err))
 		return
 	} else if echoResp.GetApiUser == nil {
 		resp.State.RemoveResource(ctx)
 		return
 	} else {
 		state.AppsyncEndpoint = types.StringValue(echoResp.GetApiUser.AppsyncEndpoint)
-		state.Credentials, diags = types.ObjectValue(
-			common.CognitoCredentialsAttrTypes(),
-			common.CognitoCredentialsAttrValues(
-				echoResp.GetApiUser.Credentials.ClientId,
-				echoResp.GetApiUser.Credentials.Password,
-				echoResp.GetApiUser.Credentials.UserPoolId,
-				echoResp.GetApiUser.Credentials.Username,
-			),
-		)
+		state.Credentials, diags = common.CognitoCredentialsValue(state.StoreCredentials, &echoResp.GetApiUser.Credentials.CognitoCredentialsFields)
 		if diags != nil && diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 		}
@@ -208,11 +215,15 @@ func (r *ApiUserResource) Schema(ctx context.Context, req resource.SchemaRequest
 					),
 				},
 			},
+			"store_credentials": common.StoreCredentialsResourceAttribute("echostream_api_user_credentials"),
 			"username": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The ApiUser's generated username.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "ApiUsers are used to programatically interact with your Tenant.",
 	}
 }
@@ -232,6 +243,16 @@ func (r *ApiUserResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var description *string
 	if !(plan.Description.IsNull() || plan.Description.IsUnknown()) {
 		temp := plan.Description.ValueString()
@@ -248,20 +269,12 @@ func (r *ApiUserResource) Update(ctx context.Context, req resource.UpdateRequest
 		(*api.ApiUserRole)(&roleValue),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating ApiUser", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating ApiUser", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
 	plan.AppsyncEndpoint = types.StringValue(echoResp.GetApiUser.Update.AppsyncEndpoint)
-	plan.Credentials, diags = types.ObjectValue(
-		common.CognitoCredentialsAttrTypes(),
-		common.CognitoCredentialsAttrValues(
-			echoResp.GetApiUser.Update.Credentials.ClientId,
-			echoResp.GetApiUser.Update.Credentials.Password,
-			echoResp.GetApiUser.Update.Credentials.UserPoolId,
-			echoResp.GetApiUser.Update.Credentials.Username,
-		),
-	)
+	plan.Credentials, diags = common.CognitoCredentialsValue(plan.StoreCredentials, &echoResp.GetApiUser.Update.Credentials.CognitoCredentialsFields)
 	if diags != nil && diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 	}
@@ -0,0 +1,112 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSourceWithConfigure = &TenantUserDataSource{}
+
+type TenantUserDataSource struct {
+	data *common.ProviderData
+}
+
+type tenantUserDataSourceModel struct {
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Role      types.String `tfsdk:"role"`
+	Status    types.String `tfsdk:"status"`
+}
+
+func (d *TenantUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*common.ProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *common.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *TenantUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_user"
+}
+
+func (d *TenantUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config tenantUserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if echoResp, err := api.ReadTenantUser(ctx, d.data.Client, config.Email.ValueString(), d.data.Tenant); err != nil {
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading TenantUser", err))
+		return
+	} else if echoResp.GetTenantUser == nil {
+		resp.Diagnostics.AddError("TenantUser not found", fmt.Sprintf("Unable to find TenantUser '%s'", config.Email.ValueString()))
+		return
+	} else {
+		config.Email = types.StringValue(echoResp.GetTenantUser.Email)
+		config.FirstName = types.StringPointerValue(echoResp.GetTenantUser.FirstName)
+		config.LastName = types.StringPointerValue(echoResp.GetTenantUser.LastName)
+		config.Role = types.StringValue(string(echoResp.GetTenantUser.Role))
+		config.Status = types.StringValue(string(echoResp.GetTenantUser.Status))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *TenantUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The user's email address.",
+				Required:            true,
+			},
+			"first_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user's first name, if available.",
+			},
+			"last_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user's last name, if available.",
+			},
+			"role": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The user's role. One of `%s`, `%s`, `%s`, or `%s`.",
+					api.UserRoleAdmin, api.UserRoleOwner, api.UserRoleReadOnly, api.UserRoleUser,
+				),
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf(
+					"The user's status. One of `%s`, `%s`, `%s`, or `%s`.",
+					api.UserStatusActive, api.UserStatusInactive, api.UserStatusInvited, api.UserStatusPending,
+				),
+			},
+		},
+		MarkdownDescription: "A specific [TenantUser](https://docs.echo.stream/docs/users-1) in the Tenant.",
+	}
+}
@@ -3,10 +3,10 @@ package user
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,11 +25,12 @@ type TenantUserResource struct {
 }
 
 type tenantUserModel struct {
-	Email     types.String `tfsdk:"email"`
-	FirstName types.String `tfsdk:"first_name"`
-	LastName  types.String `tfsdk:"last_name"`
-	Role      types.String `tfsdk:"role"`
-	Status    types.String `tfsdk:"status"`
+	Email     types.String   `tfsdk:"email"`
+	FirstName types.String   `tfsdk:"first_name"`
+	LastName  types.String   `tfsdk:"last_name"`
+	Role      types.String   `tfsdk:"role"`
+	Status    types.String   `tfsdk:"status"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TenantUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -69,7 +70,7 @@ func (r *TenantUserResource) Create(ctx context.Context, req resource.CreateRequ
 		r.data.Tenant,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating TenantUser", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error creating TenantUser", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
@@ -101,12 +102,24 @@ func (r *TenantUserResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, d := state.Timeouts.Delete(ctx, common.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if _, err := api.DeleteTenantUser(ctx, r.data.Client, state.Email.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error deleting TenantUser", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error deleting TenantUser", err))
 		return
 	}
 
-	time.Sleep(2 * time.Second)
+	if err := common.WaitUntilDeleted(ctx, tenantUserExists(r.data, state.Email.ValueString())); err != nil {
+		resp.Diagnostics.AddError("Error waiting for TenantUser to be deleted", err.Error())
+	}
 }
 
 func (r *TenantUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -128,7 +141,11 @@ func (r *TenantUserResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if echoResp, err := api.ReadTenantUser(ctx, r.data.Client, state.Email.ValueString(), r.data.Tenant); err != nil {
-		resp.Diagnostics.AddError("Error reading TenantUser", err.Error())
+		if common.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error reading TenantUser", err))
 		return
 	} else if echoResp.GetTenantUser == nil {
 		resp.State.RemoveResource(ctx)
@@ -194,6 +211,9 @@ func (r *TenantUserResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 		MarkdownDescription: "[TenantUsers](https://docs.echo.stream/docs/users-1) are used to interact with your Tenant via the UI.",
 	}
 }
@@ -208,6 +228,16 @@ func (r *TenantUserResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, d := plan.Timeouts.Update(ctx, common.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var status *api.UserStatus
 
 	if !(plan.Status.IsNull() || plan.Status.IsUnknown()) {
@@ -233,7 +263,7 @@ func (r *TenantUserResource) Update(ctx context.Context, req resource.UpdateRequ
 		status,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating TenantUser", err.Error())
+		resp.Diagnostics.Append(common.ApiErrorDiagnostic("Error updating TenantUser", err, common.AttributeNames(req.Plan.Raw)...))
 		return
 	}
 
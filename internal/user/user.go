@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+)
+
+// apiUserExists returns a function that reports whether the ApiUser with username exists, for use with the
+// common waiters.
+func apiUserExists(data *common.ProviderData, username string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadApiUser(ctx, data.Client, data.Tenant, username)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetApiUser != nil, nil
+	}
+}
+
+// tenantUserExists returns a function that reports whether the TenantUser with email exists, for use with the
+// common waiters.
+func tenantUserExists(data *common.ProviderData, email string) func(context.Context) (bool, error) {
+	return func(ctx context.Context) (bool, error) {
+		echoResp, err := api.ReadTenantUser(ctx, data.Client, email, data.Tenant)
+		if err != nil {
+			return false, err
+		}
+		return echoResp.GetTenantUser != nil, nil
+	}
+}
@@ -0,0 +1,35 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccApiUserDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "echostream_api_user" "test" {
+  description = "shared"
+  role        = "user"
+}
+
+data "echostream_api_user" "test" {
+  username = echostream_api_user.test.username
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.echostream_api_user.test", "description", "shared"),
+					resource.TestCheckResourceAttr("data.echostream_api_user.test", "role", "user"),
+					resource.TestCheckResourceAttrPair("data.echostream_api_user.test", "appsync_endpoint", "echostream_api_user.test", "appsync_endpoint"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccApiUserResource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApiUserResourceConfig(nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echostream_api_user.test", "role", "read_only"),
+					resource.TestCheckResourceAttr("echostream_api_user.test", "store_credentials", "true"),
+					resource.TestCheckResourceAttrSet("echostream_api_user.test", "credentials.password"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "echostream_api_user.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccApiUserImportStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccApiUserResourceConfig(new(bool)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echostream_api_user.test", "store_credentials", "false"),
+					resource.TestCheckNoResourceAttr("echostream_api_user.test", "credentials.password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApiUserImportStateId(s *terraform.State) (string, error) {
+	return s.RootModule().Resources["echostream_api_user.test"].Primary.Attributes["username"], nil
+}
+
+func testAccApiUserResourceConfig(storeCredentials *bool) string {
+	additonal_params := ""
+	if storeCredentials != nil {
+		additonal_params += fmt.Sprintf(`
+  store_credentials = %t`, *storeCredentials)
+	}
+	return fmt.Sprintf(`
+resource "echostream_api_user" "test" {
+  role = "read_only"%[1]s
+}
+`, additonal_params)
+}
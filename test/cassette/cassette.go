@@ -0,0 +1,296 @@
+// Package cassette records EchoStream API traffic to cassette files, and
+// replays it, so that acceptance tests may be run offline against realistic
+// responses.
+//
+// Requests are matched by operation name and normalized variables. Secrets
+// are scrubbed from both before they are written.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/Khan/genqlient/graphql"
+)
+
+// Mode is how a Recorder handles requests.
+type Mode string
+
+const (
+	// ModeRecord sends requests to the API and records them.
+	ModeRecord Mode = "record"
+	// ModeReplay answers requests from the cassette, without using the API.
+	ModeReplay Mode = "replay"
+)
+
+// Scrubbed replaces secret values in cassettes. In configs, it replaces each
+// string, so that a config keeps its shape.
+const Scrubbed = "REDACTED"
+
+// tenantPlaceholder replaces the tenant variable, so that a cassette may be
+// replayed with a different tenant than it was recorded with.
+const tenantPlaceholder = "{tenant}"
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	OperationName string          `json:"operationName"`
+	Variables     map[string]any  `json:"variables"`
+	StatusCode    int             `json:"statusCode"`
+	Response      json.RawMessage `json:"response"`
+}
+
+// Cassette is the contents of a cassette file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder records or replays the requests of the graphql.Doers that it
+// wraps.
+type Recorder struct {
+	sync.Mutex
+	cassette Cassette
+	mode     Mode
+	path     string
+	replayed map[*Interaction]bool
+}
+
+type graphqlRequest struct {
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In ModeReplay the
+// cassette must exist.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path, replayed: map[*Interaction]bool{}}
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("invalid cassette mode %q, must be %q or %q", mode, ModeRecord, ModeReplay)
+	}
+	return r, nil
+}
+
+// Wrap returns a graphql.Doer that records the requests sent to next, or
+// replays them without using next.
+func (r *Recorder) Wrap(next graphql.Doer) graphql.Doer {
+	return &recordingDoer{next: next, recorder: r}
+}
+
+// Save writes the recorded interactions to the cassette. It does nothing in
+// ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.Lock()
+	defer r.Unlock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+type recordingDoer struct {
+	next     graphql.Doer
+	recorder *Recorder
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+	var request graphqlRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil, fmt.Errorf("unable to parse GraphQL request: %w", err)
+	}
+	variables := normalize(request.Variables)
+	if d.recorder.mode == ModeReplay {
+		interaction, err := d.recorder.match(request.OperationName, variables)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			Body:       io.NopCloser(bytes.NewReader(interaction.Response)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Request:    req,
+			Status:     fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+			StatusCode: interaction.StatusCode,
+		}, nil
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := d.next.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	response, err := scrubResponse(respBody)
+	if err != nil {
+		return nil, err
+	}
+	d.recorder.Lock()
+	defer d.recorder.Unlock()
+	d.recorder.cassette.Interactions = append(d.recorder.cassette.Interactions, &Interaction{
+		OperationName: request.OperationName,
+		Variables:     variables,
+		StatusCode:    resp.StatusCode,
+		Response:      response,
+	})
+	return resp, nil
+}
+
+// match returns the first interaction for operationName and variables that
+// has not been replayed. Once all have been, the last is replayed again, as
+// Terraform may read an object more times than when it was recorded.
+func (r *Recorder) match(operationName string, variables map[string]any) (*Interaction, error) {
+	r.Lock()
+	defer r.Unlock()
+	key, _ := json.Marshal(variables)
+	var last *Interaction
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.OperationName != operationName {
+			continue
+		}
+		if recorded, _ := json.Marshal(interaction.Variables); !bytes.Equal(key, recorded) {
+			continue
+		}
+		if !r.replayed[interaction] {
+			r.replayed[interaction] = true
+			return interaction, nil
+		}
+		last = interaction
+	}
+	if last == nil {
+		return nil, fmt.Errorf("no interaction in cassette %s for %s with variables %s", r.path, operationName, key)
+	}
+	return last, nil
+}
+
+// normalize returns variables without nulls, with secrets scrubbed and the
+// tenant replaced by a placeholder.
+func normalize(variables map[string]any) map[string]any {
+	out := map[string]any{}
+	for key, value := range variables {
+		switch {
+		case value == nil:
+		case key == "tenant":
+			out[key] = tenantPlaceholder
+		default:
+			out[key] = scrub(key, value)
+		}
+	}
+	return out
+}
+
+// scrubResponse returns the JSON body with secrets scrubbed.
+func scrubResponse(body []byte) (json.RawMessage, error) {
+	var response any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&response); err != nil {
+		return nil, errors.New("unable to parse GraphQL response: " + err.Error())
+	}
+	return json.Marshal(scrub("", response))
+}
+
+// scrub returns value, the value of key, with the values of sensitive keys
+// replaced at any depth.
+func scrub(key string, value any) any {
+	if value == nil {
+		return nil
+	}
+	if common.IsSensitiveKey(key) {
+		if config, ok := value.(string); ok && strings.EqualFold(key, "config") {
+			return scrubConfig(config)
+		}
+		return scrubAll(value)
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			out[k] = scrub(k, item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = scrub("", item)
+		}
+		return out
+	}
+	return value
+}
+
+// scrubConfig returns config, a JSON string, with every string in it
+// replaced. The result is still valid JSON with the same shape, so that a
+// replayed config may be used as one. Configs that are not JSON are replaced
+// entirely.
+func scrubConfig(config string) any {
+	var value any
+	decoder := json.NewDecoder(strings.NewReader(config))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return Scrubbed
+	}
+	scrubbed, err := json.Marshal(scrubAll(value))
+	if err != nil {
+		return Scrubbed
+	}
+	return string(scrubbed)
+}
+
+// scrubAll returns value with every string in it replaced. Objects, arrays
+// and other values keep their shape, so that a replayed response still
+// decodes.
+func scrubAll(value any) any {
+	switch v := value.(type) {
+	case string:
+		return Scrubbed
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			out[k] = scrubAll(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = scrubAll(item)
+		}
+		return out
+	}
+	return value
+}
@@ -0,0 +1,140 @@
+package cassette
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"CreateApiUser":{"__typename":"ApiUser","appsyncEndpoint":"https://api.example.com/graphql","credentials":{"clientId":"client","password":"hunter2","userPoolId":"us-east-1_pool","username":"user"},"description":null,"role":"admin","username":"user"}}}`))
+	}))
+	t.Cleanup(server.Close)
+	path := filepath.Join(t.TempDir(), "fixtures", "cassette.json")
+	ctx := context.Background()
+
+	recorder, err := NewRecorder(path, ModeRecord)
+	require.NoError(t, err)
+	client := graphql.NewClient(server.URL, recorder.Wrap(http.DefaultClient))
+	recorded, err := api.CreateApiUser(ctx, client, api.ApiUserRoleAdmin, "recorded", nil)
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", recorded.CreateApiUser.Credentials.Password, "responses are not scrubbed when recording")
+	require.NoError(t, recorder.Save())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "hunter2")
+	require.NotContains(t, string(data), "recorded", "the tenant is not stored")
+
+	// Replaying matches on normalized variables, so the tenant may differ
+	recorder, err = NewRecorder(path, ModeReplay)
+	require.NoError(t, err)
+	client = graphql.NewClient(server.URL, recorder.Wrap(nil))
+	for range 2 {
+		replayed, err := api.CreateApiUser(ctx, client, api.ApiUserRoleAdmin, "replayed", nil)
+		require.NoError(t, err)
+		require.Equal(t, "user", replayed.CreateApiUser.Username)
+		require.Equal(t, Scrubbed, replayed.CreateApiUser.Credentials.Password)
+	}
+	require.Equal(t, int32(1), calls.Load(), "replaying does not send requests")
+
+	description := "other"
+	_, err = api.CreateApiUser(ctx, client, api.ApiUserRoleAdmin, "replayed", &description)
+	require.ErrorContains(t, err, "no interaction in cassette")
+}
+
+func TestRecordScrubsConfigAndCredentials(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "tenant"):
+			_, _ = w.Write([]byte(`{"data":{"GetTenant":{"Update":{"active":true,"audit":false,"config":"{\"ports\":[80,443],\"webhook_secret\":\"s3cret\"}","description":null,"name":"test","region":"us-east-1","table":"table"}}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{"CreateApiUser":{"__typename":"ApiUser","appsyncEndpoint":"https://api.example.com/graphql","credentials":{"clientId":"client","password":"hunter2","userPoolId":"us-east-1_pool","username":"user"},"description":null,"role":"admin","username":"user"}}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	ctx := context.Background()
+
+	recorder, err := NewRecorder(path, ModeRecord)
+	require.NoError(t, err)
+	config := `{"ports":[80,443],"webhook_secret":"s3cret"}`
+	_, err = api.UpdateTenant(ctx, graphql.NewClient(server.URL+"/tenant", recorder.Wrap(http.DefaultClient)), "test", nil, &config, nil)
+	require.NoError(t, err)
+	_, err = api.CreateApiUser(ctx, graphql.NewClient(server.URL, recorder.Wrap(http.DefaultClient)), api.ApiUserRoleAdmin, "test", nil)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "s3cret", "config is scrubbed from variables and responses")
+	require.NotContains(t, string(data), "us-east-1_pool", "all of the credentials are scrubbed")
+
+	// Scrubbed objects keep their shape, so that they still decode
+	recorder, err = NewRecorder(path, ModeReplay)
+	require.NoError(t, err)
+	client := graphql.NewClient(server.URL, recorder.Wrap(nil))
+	tenant, err := api.UpdateTenant(ctx, client, "test", nil, &config, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ports":[80,443],"webhook_secret":"`+Scrubbed+`"}`, *tenant.GetTenant.Update.Config, "configs are still JSON")
+	apiUser, err := api.CreateApiUser(ctx, client, api.ApiUserRoleAdmin, "test", nil)
+	require.NoError(t, err)
+	require.Equal(t, Scrubbed, apiUser.CreateApiUser.Credentials.UserPoolId)
+}
+
+func TestScrubConfig(t *testing.T) {
+	t.Parallel()
+	for config, expected := range map[string]string{
+		`{"a":"secret","b":[1,2.5,true,null,{"c":"secret"}]}`: `{"a":"REDACTED","b":[1,2.5,true,null,{"c":"REDACTED"}]}`,
+		`"secret"`:        `"REDACTED"`,
+		`[1,"secret"]`:    `[1,"REDACTED"]`,
+		`not json`:        Scrubbed,
+		`{"a":1} {"b":2}`: Scrubbed,
+		`{"big":1e400}`:   `{"big":1e400}`,
+	} {
+		require.Equal(t, expected, scrubConfig(config), config)
+	}
+}
+
+func TestReplayInOrder(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"interactions":[
+		{"operationName":"ReadKmsKey","variables":{"name":"key","tenant":"{tenant}"},"statusCode":200,"response":{"data":{"GetKmsKey":{"arn":"arn","description":"one","inUse":false,"name":"key"}}}},
+		{"operationName":"ReadKmsKey","variables":{"name":"key","tenant":"{tenant}"},"statusCode":200,"response":{"data":{"GetKmsKey":{"arn":"arn","description":"two","inUse":false,"name":"key"}}}}
+	]}`), 0o644))
+	recorder, err := NewRecorder(path, ModeReplay)
+	require.NoError(t, err)
+	client := graphql.NewClient("https://replay.invalid/graphql", recorder.Wrap(nil))
+
+	// Interactions are replayed in order, then the last is repeated
+	for _, description := range []string{"one", "two", "two"} {
+		echoResp, err := api.ReadKmsKey(context.Background(), client, "key", "tenant")
+		require.NoError(t, err)
+		require.Equal(t, description, *echoResp.GetKmsKey.Description)
+	}
+}
+
+func TestNewRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, err = NewRecorder("cassette.json", Mode("live"))
+	require.ErrorContains(t, err, "invalid cassette mode")
+}
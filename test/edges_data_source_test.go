@@ -0,0 +1,63 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccEdgesDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccEdgesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.echostream_edges.incoming", "edges.#", "1"),
+					resource.TestCheckResourceAttr("data.echostream_edges.incoming", "edges.0.source", "edgestest-one"),
+					resource.TestCheckResourceAttr("data.echostream_edges.incoming", "edges.0.message_type", "echo.text"),
+					resource.TestCheckResourceAttrPair(
+						"data.echostream_edges.incoming", "edges.0.arn",
+						"echostream_edge.test", "arn",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.echostream_edges.incoming", "edges.0.queue",
+						"echostream_edge.test", "queue",
+					),
+					resource.TestCheckResourceAttr("data.echostream_edges.outgoing", "edges.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEdgesDataSourceConfig = `
+resource "echostream_processor_node" "one" {
+	inline_processor     = "def processor(*, context, message, source, **kwargs):\n    return message\n"
+	name                 = "edgestest-one"
+	receive_message_type = "echo.text"
+	send_message_type    = "echo.text"
+}
+
+resource "echostream_processor_node" "two" {
+	inline_processor     = "def processor(*, context, message, source, **kwargs):\n    return message\n"
+	name                 = "edgestest-two"
+	receive_message_type = "echo.text"
+}
+
+resource "echostream_edge" "test" {
+	source = echostream_processor_node.one.name
+	target = echostream_processor_node.two.name
+}
+
+data "echostream_edges" "incoming" {
+	target = echostream_edge.test.target
+}
+
+data "echostream_edges" "outgoing" {
+	source = echostream_edge.test.target
+}
+`
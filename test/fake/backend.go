@@ -0,0 +1,184 @@
+package fake
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// object is a GraphQL object. Every object stored by the backend has a
+// __typename, and references to other objects are stored as the referenced
+// object itself, so that reads always see its current state.
+type object = map[string]any
+
+func typename(o object) string {
+	s, _ := o["__typename"].(string)
+	return s
+}
+
+// backend is the in-memory model of a single EchoStream tenant.
+type backend struct {
+	sync.Mutex
+	apiUsers         map[string]object
+	apps             map[string]object
+	cognito          *cognito
+	edges            map[string]object
+	endpoint         string
+	functions        map[string]object
+	kmsKeys          map[string]object
+	managedNodeTypes map[string]object
+	messageTypes     map[string]object
+	nodes            map[string]object
+	tenant           object
+	tenantUsers      map[string]object
+}
+
+func newBackend(tenant string, cognito *cognito) *backend {
+	b := &backend{
+		apiUsers:         map[string]object{},
+		apps:             map[string]object{},
+		cognito:          cognito,
+		edges:            map[string]object{},
+		functions:        map[string]object{},
+		kmsKeys:          map[string]object{},
+		managedNodeTypes: map[string]object{},
+		messageTypes:     map[string]object{},
+		nodes:            map[string]object{},
+		tenant: object{
+			"__typename":  "Tenant",
+			"active":      true,
+			"audit":       false,
+			"config":      nil,
+			"description": "Fake EchoStream Tenant",
+			"name":        tenant,
+			"region":      region,
+			"table":       "tenant-" + tenant,
+		},
+		tenantUsers: map[string]object{},
+	}
+	b.seed()
+	return b
+}
+
+// seed adds the system MessageTypes, ManagedNodeTypes and Nodes that every
+// tenant has.
+func (b *backend) seed() {
+	for _, messageType := range systemMessageTypes {
+		b.messageTypes[messageType.name] = object{
+			"__typename":        "MessageType",
+			"auditor":           "def auditor(*, message: str, **kwargs) -> dict:\n    return dict()\n",
+			"bitmapperTemplate": "def bitmapper(*, context, message, source, **kwargs) -> int:\n    return 0\n",
+			"description":       messageType.description,
+			"name":              messageType.name,
+			"processorTemplate": "def processor(*, context, message, source, **kwargs):\n    return message\n",
+			"readme":            nil,
+			"requirements":      messageType.requirements,
+			"sampleMessage":     messageType.sampleMessage,
+			"system":            true,
+		}
+	}
+	b.managedNodeTypes["echo.hl7-mllp-inbound-node"] = object{
+		"__typename":        "ManagedNodeType",
+		"configTemplate":    `{"type":"object","properties":{"port":{"type":"integer"}}}`,
+		"description":       "Receives HL7 messages over MLLP",
+		"imageUri":          "public.ecr.aws/echostream/managed-node-types/hl7-mllp-inbound-node:latest",
+		"mountRequirements": []any{},
+		"name":              "echo.hl7-mllp-inbound-node",
+		"portRequirements": []any{
+			object{"containerPort": 2575, "description": "The MLLP port", "protocol": "tcp"},
+		},
+		"readme":             nil,
+		"receiveMessageType": nil,
+		"sendMessageType":    b.messageTypes["echo.hl7"],
+		"system":             true,
+	}
+	b.managedNodeTypes["echo.hl7-mllp-outbound-node"] = object{
+		"__typename":         "ManagedNodeType",
+		"configTemplate":     `{"type":"object","properties":{"host":{"type":"string"},"port":{"type":"integer"}}}`,
+		"description":        "Sends HL7 messages over MLLP",
+		"imageUri":           "public.ecr.aws/echostream/managed-node-types/hl7-mllp-outbound-node:latest",
+		"mountRequirements":  []any{},
+		"name":               "echo.hl7-mllp-outbound-node",
+		"portRequirements":   []any{},
+		"readme":             nil,
+		"receiveMessageType": b.messageTypes["echo.hl7"],
+		"sendMessageType":    nil,
+		"system":             true,
+	}
+	for _, node := range systemNodes {
+		b.nodes[node.name] = object{
+			"__typename":         node.typename,
+			"description":        node.description,
+			"name":               node.name,
+			"receiveMessageType": b.messageTypes[node.receiveMessageType],
+			"sendMessageType":    b.messageTypes[node.sendMessageType],
+		}
+	}
+}
+
+var systemMessageTypes = []struct {
+	description   string
+	name          string
+	requirements  []any
+	sampleMessage string
+}{
+	{"Alert messages", "echo.alert", []any{}, `{"alert":"sample"}`},
+	{"Audit records", "echo.audit", []any{}, `{"audit":"sample"}`},
+	{"Change messages", "echo.change", []any{}, `{"change":"sample"}`},
+	{"CSV messages", "echo.csv", []any{}, "a,b\n1,2\n"},
+	{"Dead letter messages", "echo.dead-letter", []any{}, `{"dead_letter":"sample"}`},
+	{"HL7 messages", "echo.hl7", []any{"hl7==0.4.5"}, "MSH|^~\\&|"},
+	{"JSON messages", "echo.json", []any{}, `{"sample":true}`},
+	{"Log messages", "echo.log", []any{}, `{"log":"sample"}`},
+	{"Text messages", "echo.text", []any{}, "sample"},
+	{"Timer messages", "echo.timer", []any{}, `{"timestamp":"2024-01-01T00:00:00Z"}`},
+	{"WebSub messages", "echo.websub", []any{}, `{"content":"sample"}`},
+	{"XML messages", "echo.xml", []any{}, "<sample/>"},
+}
+
+var systemNodes = []struct {
+	description        string
+	name               string
+	receiveMessageType string
+	sendMessageType    string
+	typename           string
+}{
+	{"Emits alert messages", "Alert Emitter", "", "echo.alert", "AlertEmitterNode"},
+	{"Routes App changes", "App Change Router", "echo.change", "echo.change", "AppChangeRouterNode"},
+	{"Emits audit records", "Audit Emitter", "", "echo.audit", "AuditEmitterNode"},
+	{"Emits change messages", "Change Emitter", "", "echo.change", "ChangeEmitterNode"},
+	{"Emits dead letter messages", "Dead Letter Emitter", "", "echo.dead-letter", "DeadLetterEmitterNode"},
+	{"Emits log messages", "Log Emitter", "", "echo.log", "LogEmitterNode"},
+}
+
+// inUse returns true if any stored object references o.
+func (b *backend) inUse(o object) bool {
+	for _, collection := range []map[string]object{b.apps, b.edges, b.functions, b.managedNodeTypes, b.nodes} {
+		for _, item := range collection {
+			for _, value := range item {
+				if ref, ok := value.(object); ok && typename(ref) == typename(o) && ref["name"] == o["name"] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func newId() string {
+	b := randomBytes(16)
+	return fmt.Sprintf("%s-%s-%s-%s-%s", hex.EncodeToString(b[:4]), hex.EncodeToString(b[4:6]),
+		hex.EncodeToString(b[6:8]), hex.EncodeToString(b[8:10]), hex.EncodeToString(b[10:]))
+}
+
+func edgeKey(source any, target any) string {
+	return fmt.Sprintf("%v|%v", source, target)
+}
+
+// orNil returns nil for a missing object, so that it is returned as null.
+func orNil(o object) any {
+	if o == nil {
+		return nil
+	}
+	return o
+}
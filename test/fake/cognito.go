@@ -0,0 +1,349 @@
+package fake
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+const (
+	// https://github.com/aws/amazon-cognito-identity-js/blob/master/src/AuthenticationHelper.js#L22
+	srpNHex = "FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD1" +
+		"29024E088A67CC74020BBEA63B139B22514A08798E3404DD" +
+		"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245" +
+		"E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED" +
+		"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE45B3D" +
+		"C2007CB8A163BF0598DA48361C55D39A69163FA8FD24CF5F" +
+		"83655D23DCA3AD961C62F356208552BB9ED529077096966D" +
+		"670C354E4ABC9804F1746C08CA18217C32905E462E36CE3B" +
+		"E39E772C180E86039B2783A2EC07A28FB5C55DF06F4C52C9" +
+		"DE2BCBF6955817183995497CEA956AE515D2261898FA0510" +
+		"15728E5A8AAAC42DAD33170D04507A33A85521ABDF1CBA64" +
+		"ECFB850458DBEF0A8AEA71575D060C7DB3970F85A6E1E4C7" +
+		"ABF5AE8CDB0933D71E8C94E04A25619DCEE3D2261AD2EE6B" +
+		"F12FFA06D98A0864D87602733EC86A64521F2B18177B200C" +
+		"BBE117577A615D6C770988C0BAD946E208E24FA074E5AB31" +
+		"43DB5BFCE0FD108E4B82D120A93AD2CAFFFFFFFFFFFFFFFF"
+	srpGHex = "2"
+
+	accessTokenLifetime = time.Hour
+)
+
+var (
+	srpN = hexToBig(srpNHex)
+	srpG = hexToBig(srpGHex)
+	srpK = hexToBig(hexHash("00" + srpNHex + "0" + srpGHex))
+)
+
+// srpChallenge is the server side of an SRP exchange that is awaiting the
+// client's PASSWORD_VERIFIER response.
+type srpChallenge struct {
+	bigA     *big.Int
+	bigB     *big.Int
+	b        *big.Int
+	salt     string
+	username string
+	verifier *big.Int
+}
+
+// cognito is a fake AWS Cognito user pool. It implements the USER_SRP_AUTH
+// and REFRESH_TOKEN_AUTH flows of the InitiateAuth and
+// RespondToAuthChallenge operations, verifying passwords with SRP exactly as
+// Cognito does.
+type cognito struct {
+	sync.Mutex
+	challenges    map[string]*srpChallenge
+	clientId      string
+	key           []byte
+	refreshTokens map[string]string
+	userPoolId    string
+	users         map[string]string
+}
+
+func newCognito(userPoolId string, clientId string) *cognito {
+	return &cognito{
+		challenges:    map[string]*srpChallenge{},
+		clientId:      clientId,
+		key:           randomBytes(32),
+		refreshTokens: map[string]string{},
+		userPoolId:    userPoolId,
+		users:         map[string]string{},
+	}
+}
+
+// addUser adds a user to the pool, replacing any existing password.
+func (c *cognito) addUser(username string, password string) {
+	c.Lock()
+	defer c.Unlock()
+	c.users[username] = password
+}
+
+func (c *cognito) removeUser(username string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.users, username)
+}
+
+// ServeHTTP implements the AWS JSON 1.1 protocol used by the Cognito
+// Identity Provider service.
+func (c *cognito) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("X-Amzn-RequestId", newId())
+	var input map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeCognitoError(w, "InvalidParameterException", "Unable to parse request body")
+		return
+	}
+	if clientId, _ := input["ClientId"].(string); clientId != c.clientId {
+		writeCognitoError(w, "ResourceNotFoundException", "User pool client "+clientId+" does not exist.")
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	var (
+		output any
+		err    *cognitoError
+	)
+	switch target := r.Header.Get("X-Amz-Target"); target {
+	case "AWSCognitoIdentityProviderService.InitiateAuth":
+		output, err = c.initiateAuth(input)
+	case "AWSCognitoIdentityProviderService.RespondToAuthChallenge":
+		output, err = c.respondToAuthChallenge(input)
+	default:
+		err = &cognitoError{"UnknownOperationException", "Unsupported operation " + target}
+	}
+	if err != nil {
+		writeCognitoError(w, err.Type, err.Message)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+type cognitoError struct {
+	Type    string `json:"__type"`
+	Message string `json:"message"`
+}
+
+func writeCognitoError(w http.ResponseWriter, errorType string, message string) {
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(cognitoError{errorType, message})
+}
+
+var errNotAuthorized = &cognitoError{"NotAuthorizedException", "Incorrect username or password."}
+
+func (c *cognito) initiateAuth(input map[string]any) (any, *cognitoError) {
+	params := stringMap(input["AuthParameters"])
+	switch input["AuthFlow"] {
+	case "USER_SRP_AUTH":
+		username := params["USERNAME"]
+		password, ok := c.users[username]
+		if !ok {
+			return nil, &cognitoError{"UserNotFoundException", "User does not exist."}
+		}
+		bigA, ok := new(big.Int).SetString(params["SRP_A"], 16)
+		if !ok || new(big.Int).Mod(bigA, srpN).Sign() == 0 {
+			return nil, &cognitoError{"InvalidParameterException", "Invalid SRP_A"}
+		}
+		challenge := &srpChallenge{
+			bigA:     bigA,
+			b:        new(big.Int).Mod(new(big.Int).SetBytes(randomBytes(128)), srpN),
+			salt:     hex.EncodeToString(randomBytes(16)),
+			username: username,
+		}
+		challenge.verifier = new(big.Int).Exp(srpG, c.srpX(username, password, challenge.salt), srpN)
+		// B = (k*v + g^b) % N
+		challenge.bigB = new(big.Int).Mod(
+			new(big.Int).Add(
+				new(big.Int).Mul(srpK, challenge.verifier),
+				new(big.Int).Exp(srpG, challenge.b, srpN),
+			),
+			srpN,
+		)
+		secretBlock := base64.StdEncoding.EncodeToString(randomBytes(64))
+		c.challenges[secretBlock] = challenge
+		return map[string]any{
+			"ChallengeName": "PASSWORD_VERIFIER",
+			"ChallengeParameters": map[string]string{
+				"SALT":            challenge.salt,
+				"SECRET_BLOCK":    secretBlock,
+				"SRP_B":           challenge.bigB.Text(16),
+				"USERNAME":        username,
+				"USER_ID_FOR_SRP": username,
+			},
+		}, nil
+	case "REFRESH_TOKEN_AUTH", "REFRESH_TOKEN":
+		username, ok := c.refreshTokens[params["REFRESH_TOKEN"]]
+		if !ok {
+			return nil, &cognitoError{"NotAuthorizedException", "Invalid Refresh Token"}
+		}
+		if _, ok := c.users[username]; !ok {
+			return nil, &cognitoError{"NotAuthorizedException", "User does not exist."}
+		}
+		return c.authenticationResult(username, false)
+	}
+	return nil, &cognitoError{"InvalidParameterException", fmt.Sprintf("Unsupported AuthFlow %v", input["AuthFlow"])}
+}
+
+func (c *cognito) respondToAuthChallenge(input map[string]any) (any, *cognitoError) {
+	if input["ChallengeName"] != "PASSWORD_VERIFIER" {
+		return nil, &cognitoError{"InvalidParameterException", fmt.Sprintf("Unsupported ChallengeName %v", input["ChallengeName"])}
+	}
+	responses := stringMap(input["ChallengeResponses"])
+	secretBlock := responses["PASSWORD_CLAIM_SECRET_BLOCK"]
+	challenge, ok := c.challenges[secretBlock]
+	if !ok || challenge.username != responses["USERNAME"] {
+		return nil, &cognitoError{"NotAuthorizedException", "Invalid session for the user."}
+	}
+	delete(c.challenges, secretBlock)
+	if responses["TIMESTAMP"] == "" {
+		return nil, &cognitoError{"InvalidParameterException", "TIMESTAMP is required"}
+	}
+	// S = (A * v^u) ^ b % N
+	u := hexToBig(hexHash(padHex(challenge.bigA.Text(16)) + padHex(challenge.bigB.Text(16))))
+	s := new(big.Int).Exp(
+		new(big.Int).Mul(challenge.bigA, new(big.Int).Exp(challenge.verifier, u, srpN)),
+		challenge.b,
+		srpN,
+	)
+	secretBlockBytes, _ := base64.StdEncoding.DecodeString(secretBlock)
+	mac := hmac.New(sha256.New, computeHKDF(padHex(s.Text(16)), padHex(u.Text(16))))
+	mac.Write([]byte(c.poolName() + challenge.username + string(secretBlockBytes) + responses["TIMESTAMP"]))
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(responses["PASSWORD_CLAIM_SIGNATURE"])) {
+		return nil, errNotAuthorized
+	}
+	return c.authenticationResult(challenge.username, true)
+}
+
+// authenticationResult issues tokens for username. Refreshing does not
+// return a new refresh token.
+func (c *cognito) authenticationResult(username string, withRefreshToken bool) (any, *cognitoError) {
+	accessToken, err := c.accessToken(username)
+	if err != nil {
+		return nil, &cognitoError{"InternalErrorException", err.Error()}
+	}
+	result := map[string]any{
+		"AccessToken": accessToken,
+		"ExpiresIn":   int(accessTokenLifetime.Seconds()),
+		"IdToken":     accessToken,
+		"TokenType":   "Bearer",
+	}
+	if withRefreshToken {
+		refreshToken := base64.RawURLEncoding.EncodeToString(randomBytes(32))
+		c.refreshTokens[refreshToken] = username
+		result["RefreshToken"] = refreshToken
+	}
+	return map[string]any{"AuthenticationResult": result, "ChallengeParameters": map[string]string{}}, nil
+}
+
+func (c *cognito) accessToken(username string) (string, error) {
+	now := time.Now()
+	token, err := jwt.NewBuilder().
+		Claim("client_id", c.clientId).
+		Claim("token_use", "access").
+		Claim("username", username).
+		Expiration(now.Add(accessTokenLifetime)).
+		IssuedAt(now).
+		Issuer("https://cognito-idp.fake/" + c.userPoolId).
+		Subject(username).
+		Build()
+	if err != nil {
+		return "", err
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.HS256, c.key))
+	return string(signed), err
+}
+
+// validAccessToken returns true if token is an unexpired access token issued
+// by this pool to a user that still exists.
+func (c *cognito) validAccessToken(token string) bool {
+	parsed, err := jwt.Parse([]byte(token), jwt.WithKey(jwa.HS256, c.key), jwt.WithValidate(true))
+	if err != nil {
+		return false
+	}
+	if tokenUse, _ := parsed.Get("token_use"); tokenUse != "access" {
+		return false
+	}
+	c.Lock()
+	defer c.Unlock()
+	_, ok := c.users[parsed.Subject()]
+	return ok
+}
+
+func (c *cognito) poolName() string {
+	_, poolName, _ := strings.Cut(c.userPoolId, "_")
+	return poolName
+}
+
+// srpX returns the private key derived from the user's password.
+func (c *cognito) srpX(username string, password string, salt string) *big.Int {
+	userPassHash := sha256.Sum256([]byte(c.poolName() + username + ":" + password))
+	return hexToBig(hexHash(padHex(hexToBig(salt).Text(16)) + hex.EncodeToString(userPassHash[:])))
+}
+
+func computeHKDF(ikm string, salt string) []byte {
+	ikmBytes, _ := hex.DecodeString(ikm)
+	saltBytes, _ := hex.DecodeString(salt)
+	extractor := hmac.New(sha256.New, saltBytes)
+	extractor.Write(ikmBytes)
+	expander := hmac.New(sha256.New, extractor.Sum(nil))
+	expander.Write(append([]byte("Caldera Derived Key"), 1))
+	return expander.Sum(nil)[:16]
+}
+
+func hexHash(hexStr string) string {
+	buf, _ := hex.DecodeString(hexStr)
+	hash := sha256.Sum256(buf)
+	return hex.EncodeToString(hash[:])
+}
+
+func hexToBig(hexStr string) *big.Int {
+	i, ok := new(big.Int).SetString(hexStr, 16)
+	if !ok {
+		panic("invalid hex string " + hexStr)
+	}
+	return i
+}
+
+// padHex pads hexStr to a whole number of bytes, adding a leading zero byte
+// if it would otherwise be negative.
+func padHex(hexStr string) string {
+	if len(hexStr)%2 == 1 {
+		return "0" + hexStr
+	} else if strings.ContainsAny(hexStr[:1], "89ABCDEFabcdef") {
+		return "00" + hexStr
+	}
+	return hexStr
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func stringMap(value any) map[string]string {
+	out := map[string]string{}
+	if m, ok := value.(map[string]any); ok {
+		for key, item := range m {
+			if s, ok := item.(string); ok {
+				out[key] = s
+			}
+		}
+	}
+	return out
+}
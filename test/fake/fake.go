@@ -0,0 +1,85 @@
+// Package fake is an in-process fake of the EchoStream API, for running the
+// provider's tests without an EchoStream tenant.
+//
+// It implements the operations in internal/api against an in-memory model
+// of a single tenant, and the AWS Cognito operations that the provider uses
+// to authenticate, so that the provider's real authentication path is used.
+package fake
+
+import (
+	"net/http/httptest"
+)
+
+const (
+	clientId   = "fakeclientid"
+	userPoolId = region + "_fake"
+)
+
+// Server is a running fake EchoStream API and Cognito user pool.
+type Server struct {
+	// AppSync serves the EchoStream GraphQL API at /graphql.
+	AppSync *httptest.Server
+	// Cognito serves the Cognito Identity Provider API.
+	Cognito *httptest.Server
+	// The credentials of the ApiUser that the provider authenticates as.
+	Password string
+	Tenant   string
+	Username string
+
+	backend *backend
+}
+
+// NewServer starts a fake for tenant, with an ApiUser to authenticate as.
+// The caller must Close the Server when done.
+func NewServer(tenant string) *Server {
+	cognito := newCognito(userPoolId, clientId)
+	backend := newBackend(tenant, cognito)
+	s := &Server{
+		AppSync:  httptest.NewServer(backend),
+		Cognito:  httptest.NewServer(cognito),
+		Password: "Fake!Password1",
+		Tenant:   tenant,
+		Username: "fake-api-user",
+		backend:  backend,
+	}
+	backend.endpoint = s.Endpoint()
+	cognito.addUser(s.Username, s.Password)
+	return s
+}
+
+// ClientId is the Cognito Client Id of the ApiUser.
+func (s *Server) ClientId() string {
+	return clientId
+}
+
+// Close shuts down the Server.
+func (s *Server) Close() {
+	s.AppSync.Close()
+	s.Cognito.Close()
+}
+
+// Endpoint is the URL of the GraphQL API.
+func (s *Server) Endpoint() string {
+	return s.AppSync.URL + "/graphql"
+}
+
+// Env returns the environment that configures the provider, and the AWS SDK
+// that it uses for Cognito, to use the Server. The Server does not use TLS,
+// so any AWS CA bundle is cleared.
+func (s *Server) Env() map[string]string {
+	return map[string]string{
+		"AWS_CA_BUNDLE": "",
+		"AWS_ENDPOINT_URL_COGNITO_IDENTITY_PROVIDER": s.Cognito.URL,
+		"ECHOSTREAM_APPSYNC_ENDPOINT":                s.Endpoint(),
+		"ECHOSTREAM_CLIENT_ID":                       s.ClientId(),
+		"ECHOSTREAM_PASSWORD":                        s.Password,
+		"ECHOSTREAM_TENANT":                          s.Tenant,
+		"ECHOSTREAM_USERNAME":                        s.Username,
+		"ECHOSTREAM_USER_POOL_ID":                    s.UserPoolId(),
+	}
+}
+
+// UserPoolId is the Cognito User Pool Id of the ApiUser.
+func (s *Server) UserPoolId() string {
+	return userPoolId
+}
@@ -0,0 +1,214 @@
+package fake
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/api"
+	"github.com/Echo-Stream/terraform-provider-echostream/internal/common"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+const testTenant = "test"
+
+type tokenDoer string
+
+func (d tokenDoer) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", string(d))
+	return http.DefaultClient.Do(req)
+}
+
+func newTestClient(t *testing.T) (*Server, graphql.Client) {
+	t.Helper()
+	s := NewServer(testTenant)
+	t.Cleanup(s.Close)
+	token, err := s.backend.cognito.accessToken(s.Username)
+	require.NoError(t, err)
+	return s, graphql.NewClient(s.Endpoint(), tokenDoer(token))
+}
+
+func TestUnauthenticated(t *testing.T) {
+	t.Parallel()
+	s, _ := newTestClient(t)
+	_, err := api.ReadTenant(context.Background(), graphql.NewClient(s.Endpoint(), tokenDoer("invalid")), testTenant)
+	require.ErrorContains(t, err, "401")
+
+	_, client := newTestClient(t)
+	_, err = api.ReadTenant(context.Background(), client, "other")
+	require.ErrorContains(t, err, "Not Authorized")
+}
+
+func TestSystemObjects(t *testing.T) {
+	t.Parallel()
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	messageType, err := api.ReadMessageType(ctx, client, "echo.hl7", testTenant)
+	require.NoError(t, err)
+	require.True(t, *messageType.GetMessageType.System)
+	require.Equal(t, []string{"hl7==0.4.5"}, messageType.GetMessageType.Requirements)
+
+	node, err := api.ReadNode(ctx, client, "Alert Emitter", testTenant)
+	require.NoError(t, err)
+	require.IsType(t, &api.ReadNodeGetNodeAlertEmitterNode{}, *node.GetNode)
+
+	_, err = api.DeleteMessageType(ctx, client, "echo.hl7", testTenant)
+	require.ErrorContains(t, err, "cannot be deleted")
+}
+
+func TestNodesAndEdges(t *testing.T) {
+	t.Parallel()
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	text := "echo.text"
+	description := "processes"
+
+	_, err := api.CreateProcessorNode(ctx, client, "one", "echo.text", testTenant, nil, nil, nil, nil, nil, nil, &text, nil)
+	require.NoError(t, err)
+	_, err = api.CreateProcessorNode(ctx, client, "two", "echo.text", testTenant, nil, &description, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	_, err = api.CreateProcessorNode(ctx, client, "one", "echo.text", testTenant, nil, nil, nil, nil, nil, nil, nil, nil)
+	require.ErrorIs(t, common.TranslateError(err), common.ErrConflict)
+
+	node, err := api.ReadNode(ctx, client, "two", testTenant)
+	require.NoError(t, err)
+	processor, ok := (*node.GetNode).(*api.ReadNodeGetNodeProcessorNode)
+	require.True(t, ok)
+	require.Equal(t, description, *processor.Description)
+	require.Equal(t, "echo.text", processor.ReceiveMessageType.Name)
+	require.Nil(t, processor.SendMessageType)
+
+	edge, err := api.CreateEdge(ctx, client, "one", "two", testTenant, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, "echo.text", edge.CreateEdge.MessageType.Name)
+	require.NotEmpty(t, edge.CreateEdge.Queue)
+	_, err = api.CreateEdge(ctx, client, "two", "one", testTenant, nil, nil, nil)
+	require.ErrorContains(t, err, "does not send messages")
+
+	messageType, err := api.ReadMessageType(ctx, client, "echo.text", testTenant)
+	require.NoError(t, err)
+	require.True(t, messageType.GetMessageType.InUse)
+
+	// Deleting a Node drains its Edges
+	_, err = api.DeleteNode(ctx, client, "two", testTenant)
+	require.NoError(t, err)
+	readEdge, err := api.ReadEdge(ctx, client, "one", "two", testTenant)
+	require.NoError(t, err)
+	require.Nil(t, readEdge.GetEdge)
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	description := "updated"
+
+	_, err := api.CreateKmsKey(ctx, client, "key", testTenant, nil)
+	require.NoError(t, err)
+	updated, err := api.UpdateKmsKey(ctx, client, "key", testTenant, &description)
+	require.NoError(t, err)
+	require.Equal(t, description, *updated.GetKmsKey.Update.Description)
+	read, err := api.ReadKmsKey(ctx, client, "key", testTenant)
+	require.NoError(t, err)
+	require.Equal(t, description, *read.GetKmsKey.Description)
+	require.NotEmpty(t, read.GetKmsKey.Arn)
+}
+
+func TestApps(t *testing.T) {
+	t.Parallel()
+	s, client := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := api.CreateExternalApp(ctx, client, "external", testTenant, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, s.Endpoint(), created.CreateExternalApp.AppsyncEndpoint)
+	require.Equal(t, s.ClientId(), created.CreateExternalApp.Credentials.ClientId)
+
+	receiver, err := api.ReadNode(ctx, client, "external:Change Receiver", testTenant)
+	require.NoError(t, err)
+	require.IsType(t, &api.ReadNodeGetNodeAppChangeReceiverNode{}, *receiver.GetNode)
+
+	_, err = api.CreateExternalNode(ctx, client, "external", "node", testTenant, nil, nil, nil, nil)
+	require.NoError(t, err)
+	node, err := api.ReadNode(ctx, client, "node", testTenant)
+	require.NoError(t, err)
+	external, ok := (*node.GetNode).(*api.ReadNodeGetNodeExternalNode)
+	require.True(t, ok)
+	app, ok := external.App.(*api.ExternalNodeFieldsAppExternalApp)
+	require.True(t, ok)
+	require.Equal(t, "external", app.Name)
+
+	apps, err := api.ListApps(ctx, client, testTenant, nil)
+	require.NoError(t, err)
+	require.Len(t, apps.ListApps.Apps, 1)
+	require.Equal(t, "ExternalApp", *apps.ListApps.Apps[0].GetTypename())
+
+	// Deleting an App deletes its Nodes
+	_, err = api.DeleteApp(ctx, client, "external", testTenant)
+	require.NoError(t, err)
+	node, err = api.ReadNode(ctx, client, "node", testTenant)
+	require.NoError(t, err)
+	require.Nil(t, node.GetNode)
+}
+
+func TestListNodes(t *testing.T) {
+	t.Parallel()
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		_, err := api.CreateProcessorNode(ctx, client, name, "echo.text", testTenant, nil, nil, nil, nil, nil, nil, nil, nil)
+		require.NoError(t, err)
+	}
+
+	var (
+		names     []string
+		nextToken *string
+		pages     int
+	)
+	for {
+		echoResp, err := api.ListNodes(ctx, client, testTenant, nextToken)
+		require.NoError(t, err)
+		pages++
+		for _, node := range echoResp.ListNodes.Nodes {
+			names = append(names, node.GetName())
+		}
+		if nextToken = echoResp.ListNodes.NextToken; nextToken == nil {
+			break
+		}
+	}
+	require.Equal(t, 3, pages)
+	require.Len(t, names, len(systemNodes)+5)
+	require.Contains(t, names, "Alert Emitter")
+	require.Contains(t, names, "e")
+}
+
+func TestListEdges(t *testing.T) {
+	t.Parallel()
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	text := "echo.text"
+
+	_, err := api.CreateProcessorNode(ctx, client, "source", "echo.text", testTenant, nil, nil, nil, nil, nil, nil, &text, nil)
+	require.NoError(t, err)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		_, err = api.CreateProcessorNode(ctx, client, name, "echo.text", testTenant, nil, nil, nil, nil, nil, nil, nil, nil)
+		require.NoError(t, err)
+		_, err = api.CreateEdge(ctx, client, "source", name, testTenant, nil, nil, nil)
+		require.NoError(t, err)
+	}
+
+	first, err := api.ListEdges(ctx, client, testTenant, nil)
+	require.NoError(t, err)
+	require.Len(t, first.ListEdges.Edges, pageSize)
+	require.NotNil(t, first.ListEdges.NextToken)
+	require.Equal(t, "a", first.ListEdges.Edges[0].Target.GetName())
+	second, err := api.ListEdges(ctx, client, testTenant, first.ListEdges.NextToken)
+	require.NoError(t, err)
+	require.Len(t, second.ListEdges.Edges, 1)
+	require.Nil(t, second.ListEdges.NextToken)
+	require.Equal(t, "f", second.ListEdges.Edges[0].Target.GetName())
+	require.NotEmpty(t, second.ListEdges.Edges[0].Arn)
+}
@@ -0,0 +1,230 @@
+package fake
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// interfaces are the abstract GraphQL types of the EchoStream schema. An
+// object implements one if its __typename ends with the interface name.
+var interfaces = map[string]bool{
+	"App":      true,
+	"Function": true,
+	"Node":     true,
+}
+
+type graphqlRequest struct {
+	OperationName string         `json:"operationName"`
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// graphqlError is an error as AppSync returns it, with the errorType at the
+// top level rather than in extensions.
+type graphqlError struct {
+	ErrorType string `json:"errorType,omitempty"`
+	Message   string `json:"message"`
+	Path      []any  `json:"path,omitempty"`
+}
+
+func (e *graphqlError) Error() string {
+	return e.Message
+}
+
+func newError(errorType string, format string, a ...any) *graphqlError {
+	return &graphqlError{ErrorType: errorType, Message: fmt.Sprintf(format, a...)}
+}
+
+// executor executes a single operation against the backend.
+type executor struct {
+	backend   *backend
+	errors    []*graphqlError
+	fragments ast.FragmentDefinitionList
+	variables map[string]any
+}
+
+// ServeHTTP implements the AppSync GraphQL endpoint.
+func (b *backend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Amzn-RequestId", newId())
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !b.cognito.validAccessToken(r.Header.Get("Authorization")) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"errors": []*graphqlError{newError("UnauthorizedException", "You are not authorized to make this call.")},
+		})
+		return
+	}
+	var request graphqlRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"errors": []*graphqlError{newError("MalformedHttpRequestException", "Unable to parse GraphQL query.")},
+		})
+		return
+	}
+	data, errs := b.execute(request)
+	response := map[string]any{"data": data}
+	if len(errs) > 0 {
+		response["errors"] = errs
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// execute runs the operation in request. Operations are not validated
+// against a schema; the backend trusts that they came from internal/api.
+func (b *backend) execute(request graphqlRequest) (map[string]any, []*graphqlError) {
+	doc, err := parser.ParseQuery(&ast.Source{Input: request.Query})
+	if err != nil {
+		return nil, []*graphqlError{newError("ValidationError", "%s", err.Error())}
+	}
+	var operation *ast.OperationDefinition
+	if request.OperationName != "" {
+		operation = doc.Operations.ForName(request.OperationName)
+	} else if len(doc.Operations) == 1 {
+		operation = doc.Operations[0]
+	}
+	if operation == nil {
+		return nil, []*graphqlError{newError("ValidationError", "Unknown operation %q", request.OperationName)}
+	}
+	b.Lock()
+	defer b.Unlock()
+	e := executor{backend: b, fragments: doc.Fragments, variables: request.Variables}
+	data := map[string]any{}
+	for _, field := range e.collect(operation.SelectionSet, string(operation.Operation)) {
+		key := responseKey(field)
+		value, err := b.resolveRoot(field.Name, e.arguments(field))
+		if err != nil {
+			e.addError(err, []any{key})
+			data[key] = nil
+			continue
+		}
+		data[key] = e.project(field.SelectionSet, value, []any{key})
+	}
+	return data, e.errors
+}
+
+func (e *executor) addError(err error, path []any) {
+	var gqlErr *graphqlError
+	if !errors.As(err, &gqlErr) {
+		gqlErr = newError("InternalFailure", "%s", err.Error())
+	}
+	gqlErr.Path = append([]any{}, path...)
+	e.errors = append(e.errors, gqlErr)
+}
+
+// collect returns the fields in set that apply to typename, flattening
+// inline fragments and fragment spreads.
+func (e *executor) collect(set ast.SelectionSet, typename string) []*ast.Field {
+	var fields []*ast.Field
+	for _, selection := range set {
+		switch s := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, s)
+		case *ast.InlineFragment:
+			if implements(typename, s.TypeCondition) {
+				fields = append(fields, e.collect(s.SelectionSet, typename)...)
+			}
+		case *ast.FragmentSpread:
+			if fragment := e.fragments.ForName(s.Name); fragment != nil && implements(typename, fragment.TypeCondition) {
+				fields = append(fields, e.collect(fragment.SelectionSet, typename)...)
+			}
+		}
+	}
+	return fields
+}
+
+// project shapes value to the selections in set.
+func (e *executor) project(set ast.SelectionSet, value any, path []any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = e.project(set, item, append(append([]any{}, path...), i))
+		}
+		return out
+	case []object:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = e.project(set, item, append(append([]any{}, path...), i))
+		}
+		return out
+	case object:
+		if v == nil || len(set) == 0 {
+			return nil
+		}
+		out := map[string]any{}
+		for _, field := range e.collect(set, typename(v)) {
+			key := responseKey(field)
+			fieldPath := append(append([]any{}, path...), key)
+			resolved, err := e.backend.resolveField(v, field.Name, e.arguments(field))
+			if err != nil {
+				e.addError(err, fieldPath)
+				out[key] = nil
+				continue
+			}
+			out[key] = merge(out[key], e.project(field.SelectionSet, resolved, fieldPath))
+		}
+		return out
+	}
+	return value
+}
+
+func implements(typename string, typeCondition string) bool {
+	return typeCondition == "" || typename == typeCondition ||
+		(interfaces[typeCondition] && strings.HasSuffix(typename, typeCondition))
+}
+
+// merge combines the results of selecting the same field more than once,
+// as happens when several fragments select it.
+func merge(existing any, value any) any {
+	existingMap, ok := existing.(map[string]any)
+	if !ok {
+		return value
+	}
+	valueMap, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+	for key, item := range valueMap {
+		existingMap[key] = merge(existingMap[key], item)
+	}
+	return existingMap
+}
+
+func responseKey(field *ast.Field) string {
+	if field.Alias != "" {
+		return field.Alias
+	}
+	return field.Name
+}
+
+// arguments returns the values of the arguments of field. Arguments bound
+// to variables that were not sent are omitted, so that resolvers can tell
+// them apart from explicit nulls.
+func (e *executor) arguments(field *ast.Field) map[string]any {
+	args := map[string]any{}
+	for _, arg := range field.Arguments {
+		if arg.Value.Kind == ast.Variable {
+			if value, ok := e.variables[arg.Value.Raw]; ok {
+				args[arg.Name] = value
+			}
+			continue
+		}
+		if value, err := arg.Value.Value(e.variables); err == nil {
+			args[arg.Name] = value
+		}
+	}
+	return args
+}
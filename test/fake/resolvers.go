@@ -0,0 +1,629 @@
+package fake
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	account = "000000000000"
+	// pageSize is small, so that tests exercise pagination.
+	pageSize = 5
+	region   = "us-east-1"
+)
+
+// resolveRoot resolves a field of the Query or Mutation type.
+func (b *backend) resolveRoot(field string, args map[string]any) (any, error) {
+	if args["tenant"] != b.tenant["name"] {
+		return nil, newError("UnauthorizedException", "Not Authorized to access %s", field)
+	}
+	name := str(args["name"])
+	switch field {
+	case "GetApiUser":
+		return orNil(b.apiUsers[str(args["username"])]), nil
+	case "GetApp":
+		return orNil(b.apps[name]), nil
+	case "GetEdge":
+		return orNil(b.edges[edgeKey(args["source"], args["target"])]), nil
+	case "GetFunction":
+		return orNil(b.functions[name]), nil
+	case "GetKmsKey":
+		return orNil(b.kmsKeys[name]), nil
+	case "GetManagedNodeType":
+		return orNil(b.managedNodeTypes[name]), nil
+	case "GetMessageType":
+		return orNil(b.messageTypes[name]), nil
+	case "GetNode":
+		return orNil(b.nodes[name]), nil
+	case "GetTenant":
+		return b.tenant, nil
+	case "GetTenantUser":
+		return orNil(b.tenantUsers[str(args["email"])]), nil
+	case "ListApps":
+		return page(b.apps, "apps", args)
+	case "ListEdges":
+		return page(b.edges, "edges", args)
+	case "ListNodes":
+		return page(b.nodes, "nodes", args)
+	case "CreateApiUser":
+		return b.createApiUser(args)
+	case "CreateEdge":
+		return b.createEdge(args)
+	case "CreateKmsKey":
+		return b.createKmsKey(args)
+	case "CreateManagedNodeType":
+		return b.createManagedNodeType(args)
+	case "CreateMessageType":
+		return b.createMessageType(args)
+	}
+	kind := strings.TrimPrefix(field, "Create")
+	switch {
+	case kind == field:
+	case strings.HasSuffix(kind, "App"):
+		return b.createApp(kind, args)
+	case strings.HasSuffix(kind, "Function"):
+		return b.createFunction(kind, args)
+	case strings.HasSuffix(kind, "Node"):
+		return b.createNode(kind, args)
+	}
+	return nil, newError("ValidationError", "Validation error of type FieldUndefined: Field '%s' is undefined", field)
+}
+
+// page returns a connection of the objects in collection, in key order,
+// that follow the nextToken argument. The nextToken of a page is the key of
+// its last object.
+func page(collection map[string]object, field string, args map[string]any) (any, error) {
+	keys := make([]string, 0, len(collection))
+	for key := range collection {
+		if nextToken, ok := args["nextToken"].(string); !ok || key > nextToken {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var nextToken any
+	if len(keys) > pageSize {
+		keys = keys[:pageSize]
+		nextToken = keys[pageSize-1]
+	}
+	items := make([]object, len(keys))
+	for i, key := range keys {
+		items[i] = collection[key]
+	}
+	return object{field: items, "nextToken": nextToken}, nil
+}
+
+// resolveField resolves field of o. Fields that take arguments are the
+// operations on existing objects; all others are stored on the object.
+func (b *backend) resolveField(o object, field string, args map[string]any) (any, error) {
+	switch field {
+	case "__typename":
+		return typename(o), nil
+	case "inUse":
+		return b.inUse(o), nil
+	case "AddUser":
+		return b.addTenantUser(args)
+	case "Delete":
+		return b.delete(o)
+	case "GetAwsCredentials":
+		return b.awsCredentials(args)
+	case "Move":
+		return b.moveEdge(o, args)
+	case "Update":
+		return b.update(o, args)
+	}
+	return o[field], nil
+}
+
+// refs resolves references to other objects from arguments, keeping the
+// first error.
+type refs struct {
+	args    map[string]any
+	backend *backend
+	err     error
+}
+
+func (r *refs) lookup(key string, kind string, collection map[string]object, typenames ...string) object {
+	name, ok := r.args[key].(string)
+	if !ok || r.err != nil {
+		return nil
+	}
+	o := collection[name]
+	if o == nil {
+		r.err = newError("ValidationError", "%s %s does not exist", kind, name)
+		return nil
+	}
+	if len(typenames) > 0 && !contains(typenames, typename(o)) {
+		r.err = newError("ValidationError", "%s %s must be one of %s", kind, name, strings.Join(typenames, ", "))
+		return nil
+	}
+	return o
+}
+
+func (r *refs) app(key string, typenames ...string) object {
+	return r.lookup(key, "App", r.backend.apps, typenames...)
+}
+
+func (r *refs) function(key string, typename string) object {
+	return r.lookup(key, "Function", r.backend.functions, typename)
+}
+
+func (r *refs) messageType(key string) object {
+	return r.lookup(key, "MessageType", r.backend.messageTypes)
+}
+
+func (r *refs) node(key string) object {
+	return r.lookup(key, "Node", r.backend.nodes)
+}
+
+// set copies the arguments in keys to o, if they were sent.
+func set(o object, args map[string]any, keys ...string) {
+	for _, key := range keys {
+		if value, ok := args[key]; ok {
+			o[key] = value
+		}
+	}
+}
+
+func (b *backend) createApiUser(args map[string]any) (any, error) {
+	credentials := b.newCredentials()
+	username := str(credentials["username"])
+	apiUser := object{
+		"__typename":      "ApiUser",
+		"appsyncEndpoint": b.endpoint,
+		"credentials":     credentials,
+		"username":        username,
+	}
+	set(apiUser, args, "description", "role")
+	b.apiUsers[username] = apiUser
+	return apiUser, nil
+}
+
+func (b *backend) createApp(kind string, args map[string]any) (any, error) {
+	name := str(args["name"])
+	if b.apps[name] != nil {
+		return nil, newError("ConflictException", "App %s already exists", name)
+	}
+	app := object{"__typename": kind, "description": nil, "name": name}
+	set(app, args, "description")
+	switch kind {
+	case "CrossTenantReceivingApp":
+		app["description"] = "Receives messages from " + str(args["sendingTenant"])
+		app["sendingApp"] = nil
+		set(app, args, "sendingTenant")
+	case "CrossTenantSendingApp":
+		app["description"] = "Sends messages to " + str(args["receivingTenant"])
+		set(app, args, "receivingApp", "receivingTenant")
+	case "CrossAccountApp", "ExternalApp", "ManagedApp":
+		set(app, args, "account", "config", "tableAccess")
+		app["auditRecordsEndpoint"] = b.url("apps", name, "audit-records")
+		app["credentials"] = b.newCredentials()
+		if kind != "ManagedApp" {
+			app["appsyncEndpoint"] = b.endpoint
+		}
+		if kind == "CrossAccountApp" {
+			policy, _ := json.Marshal(map[string]any{
+				"Version": "2012-10-17",
+				"Statement": []any{map[string]any{
+					"Action":    "sqs:*",
+					"Effect":    "Allow",
+					"Principal": map[string]any{"AWS": fmt.Sprintf("arn:aws:iam::%v:root", args["account"])},
+					"Resource":  fmt.Sprintf("arn:aws:sqs:%s:%s:*", region, account),
+				}},
+			})
+			app["iamPolicy"] = string(policy)
+		}
+		if kind == "ManagedApp" {
+			app["iso"] = gzipBase64("EchoStream ManagedApp " + name + " instance image\n")
+			app["userdata"] = "#cloud-config\n# EchoStream ManagedApp " + name + "\n"
+		}
+		receiverName := name + ":Change Receiver"
+		b.nodes[receiverName] = object{
+			"__typename":         "AppChangeReceiverNode",
+			"app":                app,
+			"description":        "Receives changes for " + name,
+			"name":               receiverName,
+			"receiveMessageType": b.messageTypes["echo.change"],
+		}
+	default:
+		return nil, newError("ValidationError", "Unknown App type %s", kind)
+	}
+	b.apps[name] = app
+	return app, nil
+}
+
+func (b *backend) createEdge(args map[string]any) (any, error) {
+	r := refs{args: args, backend: b}
+	source, target := r.node("source"), r.node("target")
+	kmsKey := r.lookup("kmsKey", "KmsKey", b.kmsKeys)
+	if r.err != nil {
+		return nil, r.err
+	}
+	key := edgeKey(args["source"], args["target"])
+	if b.edges[key] != nil {
+		return nil, newError("ConflictException", "Edge %s to %s already exists", args["source"], args["target"])
+	}
+	messageType, err := edgeMessageType(source, target)
+	if err != nil {
+		return nil, err
+	}
+	id := hex.EncodeToString(randomBytes(8))
+	edge := object{
+		"__typename":      "Edge",
+		"arn":             fmt.Sprintf("arn:aws:sqs:%s:%s:edge-%s.fifo", region, account, id),
+		"description":     nil,
+		"kmsKey":          kmsKey,
+		"maxReceiveCount": nil,
+		"messageType":     messageType,
+		"queue":           fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/edge-%s.fifo", region, account, id),
+		"source":          source,
+		"target":          target,
+	}
+	set(edge, args, "description", "maxReceiveCount")
+	b.edges[key] = edge
+	return edge, nil
+}
+
+// edgeMessageType returns the MessageType of an Edge from source to target,
+// which must be the same for both.
+func edgeMessageType(source object, target object) (object, error) {
+	sendMessageType, _ := source["sendMessageType"].(object)
+	if sendMessageType == nil {
+		return nil, newError("ValidationError", "Node %s does not send messages", source["name"])
+	}
+	receiveMessageType, _ := target["receiveMessageType"].(object)
+	if receiveMessageType == nil {
+		return nil, newError("ValidationError", "Node %s does not receive messages", target["name"])
+	}
+	if sendMessageType["name"] != receiveMessageType["name"] {
+		return nil, newError(
+			"ValidationError",
+			"Node %s sends %s, but Node %s receives %s",
+			source["name"], sendMessageType["name"], target["name"], receiveMessageType["name"],
+		)
+	}
+	return sendMessageType, nil
+}
+
+func (b *backend) createFunction(kind string, args map[string]any) (any, error) {
+	name := str(args["name"])
+	if b.functions[name] != nil {
+		return nil, newError("ConflictException", "Function %s already exists", name)
+	}
+	function := object{"__typename": kind, "name": name, "readme": nil, "requirements": nil, "system": false}
+	set(function, args, "code", "description", "readme", "requirements")
+	r := refs{args: args, backend: b}
+	switch kind {
+	case "BitmapperFunction":
+		function["argumentMessageType"] = r.messageType("argumentMessageType")
+	case "ProcessorFunction":
+		function["argumentMessageType"] = r.messageType("argumentMessageType")
+		function["returnMessageType"] = r.messageType("returnMessageType")
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	b.functions[name] = function
+	return function, nil
+}
+
+func (b *backend) createKmsKey(args map[string]any) (any, error) {
+	name := str(args["name"])
+	if b.kmsKeys[name] != nil {
+		return nil, newError("ConflictException", "KmsKey %s already exists", name)
+	}
+	kmsKey := object{
+		"__typename":  "KmsKey",
+		"arn":         fmt.Sprintf("arn:aws:kms:%s:%s:key/%s", region, account, newId()),
+		"description": nil,
+		"name":        name,
+	}
+	set(kmsKey, args, "description")
+	b.kmsKeys[name] = kmsKey
+	return kmsKey, nil
+}
+
+func (b *backend) createManagedNodeType(args map[string]any) (any, error) {
+	name := str(args["name"])
+	if b.managedNodeTypes[name] != nil {
+		return nil, newError("ConflictException", "ManagedNodeType %s already exists", name)
+	}
+	r := refs{args: args, backend: b}
+	managedNodeType := object{
+		"__typename":         "ManagedNodeType",
+		"configTemplate":     nil,
+		"mountRequirements":  nil,
+		"name":               name,
+		"portRequirements":   nil,
+		"readme":             nil,
+		"receiveMessageType": r.messageType("receiveMessageType"),
+		"sendMessageType":    r.messageType("sendMessageType"),
+		"system":             false,
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	set(managedNodeType, args, "configTemplate", "description", "imageUri", "mountRequirements", "portRequirements", "readme")
+	b.managedNodeTypes[name] = managedNodeType
+	return managedNodeType, nil
+}
+
+func (b *backend) createMessageType(args map[string]any) (any, error) {
+	name := str(args["name"])
+	if b.messageTypes[name] != nil {
+		return nil, newError("ConflictException", "MessageType %s already exists", name)
+	}
+	messageType := object{"__typename": "MessageType", "name": name, "readme": nil, "requirements": nil, "system": false}
+	set(messageType, args, "auditor", "bitmapperTemplate", "description", "processorTemplate", "readme", "requirements", "sampleMessage")
+	b.messageTypes[name] = messageType
+	return messageType, nil
+}
+
+func (b *backend) createNode(kind string, args map[string]any) (any, error) {
+	name := str(args["name"])
+	if b.nodes[name] != nil {
+		return nil, newError("ConflictException", "Node %s already exists", name)
+	}
+	node := object{"__typename": kind, "description": nil, "name": name}
+	set(node, args, "description")
+	r := refs{args: args, backend: b}
+	switch kind {
+	case "BitmapRouterNode":
+		set(node, args, "config", "inlineBitmapper", "loggingLevel", "requirements", "routeTable")
+		node["managedBitmapper"] = r.function("managedBitmapper", "BitmapperFunction")
+		node["receiveMessageType"] = r.messageType("receiveMessageType")
+		node["sendMessageType"] = node["receiveMessageType"]
+	case "CrossTenantSendingNode":
+		set(node, args, "config", "inlineProcessor", "loggingLevel", "requirements", "sequentialProcessing")
+		node["app"] = r.app("app", "CrossTenantSendingApp")
+		node["managedProcessor"] = r.function("managedProcessor", "ProcessorFunction")
+		node["receiveMessageType"] = r.messageType("receiveMessageType")
+		node["sendMessageType"] = r.messageType("sendMessageType")
+	case "ExternalNode":
+		set(node, args, "config")
+		node["app"] = r.app("app", "CrossAccountApp", "ExternalApp")
+		node["receiveMessageType"] = r.messageType("receiveMessageType")
+		node["sendMessageType"] = r.messageType("sendMessageType")
+	case "FilesDotComWebhookNode":
+		set(node, args, "apiKey")
+		node["endpoint"] = b.url("nodes", name)
+		node["sendMessageType"] = b.messageTypes["echo.json"]
+		node["token"] = hex.EncodeToString(randomBytes(16))
+	case "LoadBalancerNode":
+		node["receiveMessageType"] = r.messageType("receiveMessageType")
+		node["sendMessageType"] = node["receiveMessageType"]
+	case "ManagedNode":
+		set(node, args, "config", "loggingLevel", "mounts", "ports")
+		node["app"] = r.app("app", "ManagedApp")
+		managedNodeType := r.lookup("managedNodeType", "ManagedNodeType", b.managedNodeTypes)
+		if managedNodeType != nil {
+			node["managedNodeType"] = managedNodeType
+			node["receiveMessageType"] = managedNodeType["receiveMessageType"]
+			node["sendMessageType"] = managedNodeType["sendMessageType"]
+		}
+	case "ProcessorNode":
+		set(node, args, "config", "inlineProcessor", "loggingLevel", "requirements", "sequentialProcessing")
+		node["managedProcessor"] = r.function("managedProcessor", "ProcessorFunction")
+		node["receiveMessageType"] = r.messageType("receiveMessageType")
+		node["sendMessageType"] = r.messageType("sendMessageType")
+	case "TimerNode":
+		set(node, args, "scheduleExpression")
+		node["sendMessageType"] = b.messageTypes["echo.timer"]
+	case "WebhookNode":
+		set(node, args, "config", "inlineApiAuthenticator", "loggingLevel", "requirements")
+		node["endpoint"] = b.url("nodes", name)
+		node["managedApiAuthenticator"] = r.function("managedApiAuthenticator", "ApiAuthenticatorFunction")
+		if sendMessageType := r.messageType("sendMessageType"); sendMessageType != nil {
+			node["sendMessageType"] = sendMessageType
+		} else {
+			node["sendMessageType"] = b.messageTypes["echo.json"]
+		}
+	case "WebSubHubNode":
+		node["defaultLeaseSeconds"] = 864000
+		node["deliveryRetries"] = 2880
+		node["maxLeaseSeconds"] = 864000
+		node["signatureAlgorithm"] = "sha256"
+		node["subscriptionSecurity"] = nil
+		for key, value := range args {
+			if value != nil {
+				node[key] = value
+			}
+		}
+		delete(node, "tenant")
+		node["endpoint"] = b.url("nodes", name)
+		node["managedApiAuthenticator"] = r.function("managedApiAuthenticator", "ApiAuthenticatorFunction")
+		node["receiveMessageType"] = b.messageTypes["echo.websub"]
+	default:
+		return nil, newError("ValidationError", "Unknown Node type %s", kind)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	b.nodes[name] = node
+	return node, nil
+}
+
+func (b *backend) addTenantUser(args map[string]any) (any, error) {
+	email := str(args["email"])
+	if b.tenantUsers[email] != nil {
+		return nil, newError("ConflictException", "TenantUser %s already exists", email)
+	}
+	tenantUser := object{
+		"__typename": "TenantUser",
+		"email":      email,
+		"firstName":  nil,
+		"lastName":   nil,
+		"status":     "invited",
+	}
+	set(tenantUser, args, "role")
+	b.tenantUsers[email] = tenantUser
+	return tenantUser, nil
+}
+
+func (b *backend) awsCredentials(args map[string]any) (any, error) {
+	duration := 3600
+	if value, ok := args["duration"].(float64); ok {
+		duration = int(value)
+	} else if value, ok := args["duration"].(int64); ok {
+		duration = int(value)
+	}
+	if duration < 900 || duration > 43200 {
+		return nil, newError("ValidationError", "duration must be between 900 and 43200 seconds")
+	}
+	return object{
+		"__typename":      "AwsCredentials",
+		"accessKeyId":     "ASIA" + strings.ToUpper(hex.EncodeToString(randomBytes(8))),
+		"expiration":      time.Now().Add(time.Duration(duration) * time.Second).UTC().Format(time.RFC3339),
+		"secretAccessKey": hex.EncodeToString(randomBytes(20)),
+		"sessionToken":    hex.EncodeToString(randomBytes(64)),
+	}, nil
+}
+
+func (b *backend) delete(o object) (any, error) {
+	kind := typename(o)
+	name := str(o["name"])
+	switch {
+	case kind == "ApiUser":
+		delete(b.apiUsers, str(o["username"]))
+		b.cognito.removeUser(str(o["username"]))
+	case kind == "Edge":
+		delete(b.edges, edgeKey(o["source"].(object)["name"], o["target"].(object)["name"]))
+	case kind == "TenantUser":
+		delete(b.tenantUsers, str(o["email"]))
+	case strings.HasSuffix(kind, "App"):
+		for _, node := range b.nodes {
+			if app, _ := node["app"].(object); app != nil && app["name"] == name {
+				b.deleteNode(node)
+			}
+		}
+		if credentials, ok := o["credentials"].(object); ok {
+			b.cognito.removeUser(str(credentials["username"]))
+		}
+		delete(b.apps, name)
+	case strings.HasSuffix(kind, "Node"):
+		b.deleteNode(o)
+	default:
+		if o["system"] == true {
+			return nil, newError("ValidationError", "System %s %s cannot be deleted", kind, name)
+		}
+		if b.inUse(o) {
+			return nil, newError("ConflictException", "%s %s is in use", kind, name)
+		}
+		switch {
+		case kind == "KmsKey":
+			delete(b.kmsKeys, name)
+		case kind == "ManagedNodeType":
+			delete(b.managedNodeTypes, name)
+		case kind == "MessageType":
+			delete(b.messageTypes, name)
+		case strings.HasSuffix(kind, "Function"):
+			delete(b.functions, name)
+		}
+	}
+	return true, nil
+}
+
+// deleteNode deletes node and drains its Edges.
+func (b *backend) deleteNode(node object) {
+	for key, edge := range b.edges {
+		if edge["source"].(object)["name"] == node["name"] || edge["target"].(object)["name"] == node["name"] {
+			delete(b.edges, key)
+		}
+	}
+	delete(b.nodes, str(node["name"]))
+}
+
+func (b *backend) moveEdge(edge object, args map[string]any) (any, error) {
+	r := refs{args: args, backend: b}
+	source, target := r.node("source"), r.node("target")
+	if r.err != nil {
+		return nil, r.err
+	}
+	key := edgeKey(args["source"], args["target"])
+	if b.edges[key] != nil {
+		return nil, newError("ConflictException", "Edge %s to %s already exists", args["source"], args["target"])
+	}
+	if messageType, err := edgeMessageType(source, target); err != nil {
+		return nil, err
+	} else if messageType["name"] != edge["messageType"].(object)["name"] {
+		return nil, newError("ValidationError", "Edges cannot be moved to a different MessageType")
+	}
+	delete(b.edges, edgeKey(edge["source"].(object)["name"], edge["target"].(object)["name"]))
+	edge["source"] = source
+	edge["target"] = target
+	b.edges[key] = edge
+	return edge, nil
+}
+
+// update sets the arguments that were sent on o.
+func (b *backend) update(o object, args map[string]any) (any, error) {
+	if o["system"] == true {
+		return nil, newError("ValidationError", "System %s %s cannot be updated", typename(o), o["name"])
+	}
+	r := refs{args: args, backend: b}
+	for key, value := range args {
+		switch key {
+		case "managedApiAuthenticator":
+			o[key] = r.function(key, "ApiAuthenticatorFunction")
+		case "managedBitmapper":
+			o[key] = r.function(key, "BitmapperFunction")
+		case "managedProcessor":
+			o[key] = r.function(key, "ProcessorFunction")
+		default:
+			o[key] = value
+		}
+	}
+	return o, r.err
+}
+
+func (b *backend) newCredentials() object {
+	username := newId()
+	password := hex.EncodeToString(randomBytes(16)) + "!Aa1"
+	b.cognito.addUser(username, password)
+	return object{
+		"__typename": "CognitoCredentials",
+		"clientId":   b.cognito.clientId,
+		"password":   password,
+		"userPoolId": b.cognito.userPoolId,
+		"username":   username,
+	}
+}
+
+// url returns a URL on the fake's server for path.
+func (b *backend) url(path ...string) string {
+	for i, p := range path {
+		path[i] = url.PathEscape(p)
+	}
+	return strings.TrimSuffix(b.endpoint, "/graphql") + "/" + strings.Join(path, "/")
+}
+
+// gzipBase64 returns content gzip'd and base64 encoded, as ManagedApp iso
+// images are returned.
+func gzipBase64(content string) string {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, _ = w.Write([]byte(content))
+	_ = w.Close()
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func str(value any) string {
+	s, _ := value.(string)
+	return s
+}
@@ -0,0 +1,35 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKmsKeyDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "echostream_kms_key" "test" {
+  description = "shared"
+  name        = "test-data-source"
+}
+
+data "echostream_kms_key" "test" {
+  name = echostream_kms_key.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.echostream_kms_key.test", "description", "shared"),
+					resource.TestCheckResourceAttr("data.echostream_kms_key.test", "in_use", "false"),
+					resource.TestCheckResourceAttrSet("data.echostream_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
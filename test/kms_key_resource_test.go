@@ -13,7 +13,7 @@ func TestAccKmsKeyResource(t *testing.T) {
 	description := "two"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -13,7 +13,7 @@ func TestAccManagedNodeTypeResource(t *testing.T) {
 	readme := "This is a readme"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -11,7 +11,7 @@ func TestAccMessageTypeDataSource(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
 		Steps: []resource.TestStep{
 			// Read testing
 			{
@@ -0,0 +1,52 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNodeDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccNodeDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.echostream_node.timer", "type", "TimerNode"),
+					resource.TestCheckResourceAttr("data.echostream_node.timer", "description", "every five minutes"),
+					resource.TestCheckResourceAttr("data.echostream_node.timer", "timer_node.schedule_expression", "rate(5 minutes)"),
+					resource.TestCheckResourceAttr("data.echostream_node.timer", "timer_node.send_message_type", "echo.timer"),
+					resource.TestCheckNoResourceAttr("data.echostream_node.timer", "processor_node.%"),
+					resource.TestCheckResourceAttr("data.echostream_node.alert", "type", "AlertEmitterNode"),
+					resource.TestCheckNoResourceAttr("data.echostream_node.alert", "timer_node.%"),
+					resource.TestCheckResourceAttr("data.echostream_timer_node.timer", "schedule_expression", "rate(5 minutes)"),
+					resource.TestCheckResourceAttr("data.echostream_timer_node.timer", "send_message_type", "echo.timer"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNodeDataSourceConfig = `
+resource "echostream_timer_node" "test" {
+  description         = "every five minutes"
+  name                = "test-node-data-source"
+  schedule_expression = "rate(5 minutes)"
+}
+
+data "echostream_node" "timer" {
+  name = echostream_timer_node.test.name
+}
+
+data "echostream_node" "alert" {
+  name = "Alert Emitter"
+}
+
+data "echostream_timer_node" "timer" {
+  name = echostream_timer_node.test.name
+}
+`
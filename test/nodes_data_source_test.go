@@ -0,0 +1,41 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNodesDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccNodesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.echostream_nodes.alert", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.echostream_nodes.alert", "nodes.0.name", "Alert Emitter"),
+					resource.TestCheckResourceAttr("data.echostream_nodes.alert", "nodes.0.send_message_type", "echo.alert"),
+					resource.TestCheckResourceAttr("data.echostream_nodes.alert", "nodes.0.type", "AlertEmitterNode"),
+					resource.TestCheckNoResourceAttr("data.echostream_nodes.alert", "nodes.0.receive_message_type"),
+					resource.TestCheckResourceAttr("data.echostream_nodes.log", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.echostream_nodes.log", "nodes.0.name", "Log Emitter"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNodesDataSourceConfig = `
+data "echostream_nodes" "alert" {
+	types = ["AlertEmitterNode"]
+}
+
+data "echostream_nodes" "log" {
+	message_type = "echo.log"
+	name_regex   = "^Log "
+}
+`
@@ -2,9 +2,13 @@ package test
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Echo-Stream/terraform-provider-echostream/internal/provider"
+	"github.com/Echo-Stream/terraform-provider-echostream/test/cassette"
+	"github.com/Echo-Stream/terraform-provider-echostream/test/fake"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/stretchr/testify/require"
@@ -14,6 +18,9 @@ import (
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
+//
+// When ECHOSTREAM_FAKE=1, TestMain points the provider at an in-process fake
+// of the EchoStream API and Cognito, so the suite runs hermetically.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"echostream": providerserver.NewProtocol6WithError(provider.New("test")()),
 }
@@ -24,3 +31,57 @@ func testAccPreCheck(t *testing.T) {
 		require.NotEmpty(t, os.Getenv("ECHOSTREAM_"+keySuffix), "ECHOSTREAM_"+keySuffix+" must be set")
 	}
 }
+
+// testAccProtoV6ProviderFactoriesWithCassette returns the provider factories
+// for t. When ECHOSTREAM_CASSETTE_MODE is "record" the API traffic of t is
+// recorded to a cassette in fixtures, and when it is "replay" it is answered
+// from that cassette without using the API.
+func testAccProtoV6ProviderFactoriesWithCassette(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	mode := os.Getenv("ECHOSTREAM_CASSETTE_MODE")
+	if mode == "" {
+		return testAccProtoV6ProviderFactories
+	}
+	recorder, err := cassette.NewRecorder(
+		filepath.Join("fixtures", strings.ReplaceAll(t.Name(), "/", "_")+".json"),
+		cassette.Mode(mode),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if t.Failed() {
+			t.Log("Not saving the cassette of a failed test")
+			return
+		}
+		require.NoError(t, recorder.Save())
+	})
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"echostream": providerserver.NewProtocol6WithError(provider.NewWithDoerWrapper("test", recorder.Wrap)()),
+	}
+}
+
+func TestMain(m *testing.M) {
+	switch {
+	case os.Getenv("ECHOSTREAM_FAKE") == "1":
+		server := fake.NewServer("test")
+		setEnv(server.Env())
+		code := m.Run()
+		server.Close()
+		os.Exit(code)
+	case os.Getenv("ECHOSTREAM_CASSETTE_MODE") == string(cassette.ModeReplay):
+		// Replaying does not use the API, so the configuration is a placeholder
+		setEnv(map[string]string{
+			"ECHOSTREAM_APPSYNC_ENDPOINT": "https://replay.invalid/graphql",
+			"ECHOSTREAM_CLIENT_ID":        "replay",
+			"ECHOSTREAM_PASSWORD":         "replay",
+			"ECHOSTREAM_TENANT":           "replay",
+			"ECHOSTREAM_USERNAME":         "replay",
+			"ECHOSTREAM_USER_POOL_ID":     "us-east-1_replay",
+		})
+	}
+	os.Exit(m.Run())
+}
+
+func setEnv(env map[string]string) {
+	for key, value := range env {
+		os.Setenv(key, value)
+	}
+}
@@ -0,0 +1,32 @@
+package test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTenantGraphDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "echostream_tenant_graph" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.echostream_tenant_graph.test", "nodes.*", map[string]string{
+						"name":              "Alert Emitter",
+						"send_message_type": "echo.alert",
+						"type":              "AlertEmitterNode",
+					}),
+					resource.TestMatchResourceAttr("data.echostream_tenant_graph.test", "dot", regexp.MustCompile(`^digraph `)),
+					resource.TestMatchResourceAttr("data.echostream_tenant_graph.test", "dot", regexp.MustCompile(`"Alert Emitter" \[label="Alert Emitter\\nAlertEmitterNode"\];`)),
+					resource.TestMatchResourceAttr("data.echostream_tenant_graph.test", "mermaid", regexp.MustCompile(`^flowchart LR\n`)),
+				),
+			},
+		},
+	})
+}
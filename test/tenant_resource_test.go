@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Echo-Stream/terraform-provider-echostream/test/cassette"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -12,14 +13,15 @@ func TestAccTenantResource(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
 		Steps: []resource.TestStep{
-			// Create and Read testing
+			// Create and Read testing. Strings in configs are scrubbed from
+			// cassettes, so only the scrubbed value is replayed as it was set.
 			{
-				Config: testAccTenantResourceConfig("one", map[string]any{"foo": "bar"}),
+				Config: testAccTenantResourceConfig("one", map[string]any{"foo": cassette.Scrubbed}),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("echostream_tenant.test", "description", "one"),
-					resource.TestCheckResourceAttr("echostream_tenant.test", "config", "{\"foo\":\"bar\"}"),
+					resource.TestCheckResourceAttr("echostream_tenant.test", "config", "{\"foo\":\""+cassette.Scrubbed+"\"}"),
 				),
 			},
 			// ImportState testing
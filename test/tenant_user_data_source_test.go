@@ -0,0 +1,34 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTenantUserDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithCassette(t),
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "echostream_tenant_user" "test" {
+  email = "data-source@example.com"
+  role  = "read_only"
+}
+
+data "echostream_tenant_user" "test" {
+  email = echostream_tenant_user.test.email
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.echostream_tenant_user.test", "role", "read_only"),
+					resource.TestCheckResourceAttrPair("data.echostream_tenant_user.test", "status", "echostream_tenant_user.test", "status"),
+				),
+			},
+		},
+	})
+}